@@ -1,4 +1,14 @@
 # Get a single workflow using an ID.
-data "n8n_workflow" "test" {
-  workflow_id = ""
+data "n8n_workflow" "by_id" {
+  id = "3LODqkaWPmYOi0FA"
+}
+
+# Get a single workflow using its name, which is usually stable across instances.
+data "n8n_workflow" "by_name" {
+  name = "Order Sync"
+}
+
+# Expose the production webhook URLs, for example to an API gateway.
+output "order_sync_webhooks" {
+  value = [for webhook in data.n8n_workflow.by_name.webhooks : webhook.production_url]
 }
@@ -0,0 +1,22 @@
+# The bootstrap alias has no host or token, so it can only be used for
+# ephemeral resources.
+provider "n8n" {
+  alias = "bootstrap"
+}
+
+# Fetch the token from a secret manager on every run
+ephemeral "n8n_api_token" "vault" {
+  provider = n8n.bootstrap
+  command  = ["vault", "kv", "get", "-field=token", "secret/n8n"]
+}
+
+provider "n8n" {
+  host  = "https://n8n.example.com"
+  token = ephemeral.n8n_api_token.vault.token
+}
+
+# Or read a token written to disk by another tool
+ephemeral "n8n_api_token" "file" {
+  provider = n8n.bootstrap
+  file     = "/var/run/secrets/n8n/token"
+}
@@ -0,0 +1,8 @@
+# Read a workflow exported from the n8n editor.
+locals {
+  workflow = provider::n8n::workflow_decode(file("${path.module}/workflow.json"))
+}
+
+output "node_names" {
+  value = [for node in local.workflow.nodes : node.name]
+}
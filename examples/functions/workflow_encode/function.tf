@@ -0,0 +1,10 @@
+# Rename an exported workflow and encode it back to canonical JSON.
+locals {
+  workflow = provider::n8n::workflow_decode(file("${path.module}/workflow.json"))
+}
+
+output "workflow_json" {
+  value = provider::n8n::workflow_encode(merge(local.workflow, {
+    name = "${local.workflow.name} (production)"
+  }))
+}
@@ -0,0 +1,4 @@
+# Extract a single node from an exported workflow.
+output "http_request_url" {
+  value = provider::n8n::workflow_node(file("${path.module}/workflow.json"), "HTTP Request").parameters.url
+}
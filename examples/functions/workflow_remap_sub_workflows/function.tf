@@ -0,0 +1,10 @@
+# Deploy a parent workflow together with the sub-workflow it runs.
+resource "n8n_workflow" "notify" {
+  workflow_json = file("${path.module}/notify.json")
+}
+
+resource "n8n_workflow" "orders" {
+  workflow_json = provider::n8n::workflow_remap_sub_workflows(file("${path.module}/orders.json"), {
+    "Notify" = n8n_workflow.notify.id
+  })
+}
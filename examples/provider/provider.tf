@@ -3,3 +3,46 @@ provider "n8n" {
   host  = "http://localhost:5678"
   token = "..."
 }
+
+# n8n served under a subpath, failing fast on a bad token or unreachable host
+provider "n8n" {
+  alias             = "subpath"
+  host              = "https://example.com/n8n"
+  token             = "..."
+  verify_connection = true
+}
+
+# Identify the pipeline making changes in the n8n access logs
+provider "n8n" {
+  alias             = "ci"
+  host              = "http://localhost:5678"
+  token             = "..."
+  user_agent_suffix = "github-actions/deploy-workflows"
+}
+
+# Throttle requests to a small n8n instance
+provider "n8n" {
+  alias                   = "throttled"
+  host                    = "http://localhost:5678"
+  token                   = "..."
+  requests_per_second     = 5
+  max_concurrent_requests = 2
+}
+
+# Read host and token from the [prod-eu] section of ~/.config/n8n/credentials:
+#
+#   [prod-eu]
+#   host  = https://eu.n8n.example.com
+#   token = ...
+provider "n8n" {
+  alias   = "prod_eu"
+  profile = "prod-eu"
+}
+
+# Read the token from a file on every run, for example one rotated by a
+# secret manager agent
+provider "n8n" {
+  alias      = "token_file"
+  host       = "http://localhost:5678"
+  token_file = "/var/run/secrets/n8n/token"
+}
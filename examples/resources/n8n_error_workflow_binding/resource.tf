@@ -0,0 +1,20 @@
+# Send the failures of every production workflow to a central alerting workflow.
+data "n8n_workflow" "alerting" {
+  name = "Alerting"
+}
+
+data "n8n_workflow" "orders" {
+  name = "Process Orders"
+}
+
+resource "n8n_error_workflow_binding" "production" {
+  error_workflow_id = data.n8n_workflow.alerting.id
+  workflow_ids      = [data.n8n_workflow.orders.id]
+  tags              = ["production"]
+}
+
+# Workflows that do not point at the alerting workflow anymore, such as
+# workflows tagged since the last apply.
+output "drifted_workflows" {
+  value = n8n_error_workflow_binding.production.drifted_workflow_ids
+}
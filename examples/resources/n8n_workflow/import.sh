@@ -0,0 +1,2 @@
+# Workflows can be imported by specifying the workflow ID.
+terraform import n8n_workflow.example 3LODqkaWPmYOi0FA
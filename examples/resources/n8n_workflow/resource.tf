@@ -0,0 +1,6 @@
+# Manage a workflow exported from the n8n editor.
+resource "n8n_workflow" "example" {
+  workflow_json         = file("${path.module}/workflow.json")
+  active                = true
+  ignore_node_positions = true
+}
@@ -0,0 +1,34 @@
+# Promote workflows from a staging instance to production. Credentials are
+# matched by type and name on the production instance.
+provider "n8n" {
+  alias = "staging"
+  host  = "https://n8n.staging.example.com"
+}
+
+provider "n8n" {
+  host = "https://n8n.example.com"
+}
+
+data "n8n_workflow" "notify" {
+  provider = n8n.staging
+  name     = "Notify"
+}
+
+data "n8n_workflow" "orders" {
+  provider = n8n.staging
+  name     = "Process Orders"
+}
+
+resource "n8n_workflow_copy" "notify" {
+  source_workflow_json = data.n8n_workflow.notify.workflow_json
+}
+
+resource "n8n_workflow_copy" "orders" {
+  source_workflow_json = data.n8n_workflow.orders.workflow_json
+  active               = true
+
+  # Point the Execute Workflow nodes at the production copy.
+  workflow_ids = {
+    (data.n8n_workflow.notify.id) = n8n_workflow_copy.notify.id
+  }
+}
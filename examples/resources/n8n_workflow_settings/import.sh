@@ -0,0 +1,2 @@
+# Workflow settings can be imported by specifying the workflow ID.
+terraform import n8n_workflow_settings.example 3LODqkaWPmYOi0FA
@@ -0,0 +1,12 @@
+# Enforce the execution settings of a workflow edited in the n8n editor.
+data "n8n_workflow" "orders" {
+  name = "Process Orders"
+}
+
+resource "n8n_workflow_settings" "orders" {
+  workflow_id               = data.n8n_workflow.orders.id
+  error_workflow            = "VzqKEW0ShTXA5vPj"
+  execution_timeout         = 300
+  save_data_error_execution = "all"
+  timezone                  = "Europe/Berlin"
+}
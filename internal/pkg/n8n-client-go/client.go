@@ -11,10 +11,14 @@
 package n8n
 
 import (
+	"context"
+	"errors"
 	"fmt"
 	"io"
 	"net/http"
 	"time"
+
+	"golang.org/x/time/rate"
 )
 
 // Client represents a client for the n8n service.
@@ -22,15 +26,49 @@ type Client struct {
 	HostURL    string
 	HTTPClient *http.Client
 	Token      string
+
+	// UserAgent is sent in the User-Agent header of every request.
+	UserAgent string
+
+	// Logger, when set, receives a redacted debug record of every request.
+	Logger Logger
+
+	// limiter and slots throttle requests, see WithRateLimit and
+	// WithMaxConcurrentRequests.
+	limiter *rate.Limiter
+	slots   chan struct{}
+
+	// ctx is the context of every request, see WithContext.
+	ctx context.Context
+}
+
+// DefaultUserAgent is the User-Agent sent when none is configured.
+const DefaultUserAgent = "n8n-client-go"
+
+// ClientOption configures optional behaviour of a Client.
+type ClientOption func(*Client)
+
+// WithUserAgent sets the User-Agent header sent with every request, so the
+// n8n access logs can attribute traffic to the application using the client.
+//
+// Example:
+//
+//	n8n.WithUserAgent("terraform-provider-n8n/1.0.0 (terraform 1.9.0)")
+func WithUserAgent(userAgent string) ClientOption {
+	return func(c *Client) {
+		c.UserAgent = userAgent
+	}
 }
 
 // NewClient creates a new n8n client.
-// It accepts a base URL and an API key for authentication.
+// It accepts a base URL, normalized with NormalizeHostURL, and an API key for
+// authentication, followed by options such as WithUserAgent, WithLogger and
+// WithRateLimit.
 //
 // Example:
 //
 //	client := n8n.NewClient("https://example.n8n.io", "your-api-key")
-func NewClient(host *string, token *string) (*Client, error) {
+func NewClient(host *string, token *string, opts ...ClientOption) (*Client, error) {
 	if token == nil {
 		return nil, fmt.Errorf("token is required")
 	}
@@ -39,34 +77,104 @@ func NewClient(host *string, token *string) (*Client, error) {
 		return nil, fmt.Errorf("host is required")
 	}
 
+	hostURL, err := NormalizeHostURL(*host)
+	if err != nil {
+		return nil, err
+	}
+
 	c := Client{
 		HTTPClient: &http.Client{Timeout: 10 * time.Second},
+		UserAgent:  DefaultUserAgent,
 	}
 
-	c.HostURL = *host
+	c.HostURL = hostURL
 	c.Token = *token
 
+	for _, opt := range opts {
+		opt(&c)
+	}
+
 	return &c, nil
 }
 
+// WithContext returns a copy of the client making its requests with ctx, so
+// they are cancelled with it and logged with its values. The copy shares the
+// HTTP client and the request limits of c.
+//
+// Example:
+//
+//	workflow, err := client.WithContext(ctx).GetWorkflow("3LODqkaWPmYOi0FA")
+func (c *Client) WithContext(ctx context.Context) *Client {
+	copied := *c
+	copied.ctx = ctx
+	return &copied
+}
+
+// context returns the context of the client requests.
+func (c *Client) context() context.Context {
+	if c.ctx == nil {
+		return context.Background()
+	}
+	return c.ctx
+}
+
+// APIError is returned when the n8n API responds with a non-200 status code.
+type APIError struct {
+	// StatusCode is the HTTP status code of the response.
+	StatusCode int
+
+	// Body is the raw response body.
+	Body string
+}
+
+func (e *APIError) Error() string {
+	return fmt.Sprintf("status: %d, body: %s", e.StatusCode, e.Body)
+}
+
+// IsNotFound reports whether the error is an APIError with HTTP status 404.
+func IsNotFound(err error) bool {
+	var apiErr *APIError
+	return errors.As(err, &apiErr) && apiErr.StatusCode == http.StatusNotFound
+}
+
 func (c *Client) doRequest(req *http.Request) ([]byte, error) {
 	token := c.Token
 
+	userAgent := c.UserAgent
+	if userAgent == "" {
+		userAgent = DefaultUserAgent
+	}
+
 	req.Header.Set("X-N8N-API-KEY", token)
+	req.Header.Set("User-Agent", userAgent)
+
+	release, err := c.acquire(req.Context())
+	if err != nil {
+		return nil, err
+	}
+	defer release()
+
+	var requestBody []byte
+	if c.Logger != nil {
+		requestBody = readRequestBody(req)
+	}
 
+	start := time.Now()
 	res, err := c.HTTPClient.Do(req)
 	if err != nil {
+		c.logRequest(req, requestBody, nil, nil, time.Since(start), err)
 		return nil, err
 	}
 	defer res.Body.Close()
 
 	body, err := io.ReadAll(res.Body)
+	c.logRequest(req, requestBody, res, body, time.Since(start), err)
 	if err != nil {
 		return nil, err
 	}
 
 	if res.StatusCode != http.StatusOK {
-		return nil, fmt.Errorf("status: %d, body: %s", res.StatusCode, body)
+		return nil, &APIError{StatusCode: res.StatusCode, Body: string(body)}
 	}
 
 	return body, err
@@ -12,18 +12,26 @@ import (
 	"github.com/stretchr/testify/require"
 )
 
-func TestIntegrationGetWorkflows(t *testing.T) {
-	// Create and start the n8n container
+// newIntegrationClient returns a client for a fresh n8n container, which is
+// terminated when the test ends.
+func newIntegrationClient(t *testing.T) *Client {
+	t.Helper()
+
+	// Start the n8n container for testing
 	container, url, err := helpers.CreateTestContainer()
 	require.NoError(t, err)
 
 	// Ensure container is cleaned up after the test
-	defer helpers.DeferTerminate(container)()
+	t.Cleanup(helpers.DeferTerminate(container))
 
 	client, err := NewClient(&url, &config.ApiToken)
-	if err != nil {
-		t.Fatalf("failed to create client: %v", err)
-	}
+	require.NoError(t, err)
+
+	return client
+}
+
+func TestIntegrationGetWorkflows(t *testing.T) {
+	client := newIntegrationClient(t)
 
 	workflows, err := client.GetWorkflows()
 
@@ -37,15 +45,7 @@ func TestIntegrationGetWorkflows(t *testing.T) {
 }
 
 func TestIntegrationCreateWorkflow(t *testing.T) {
-	// Start the n8n container for testing
-	container, url, err := helpers.CreateTestContainer()
-	require.NoError(t, err)
-
-	defer helpers.DeferTerminate(container)()
-
-	// Create a client for the running container
-	client, err := NewClient(&url, &config.ApiToken)
-	require.NoError(t, err)
+	client := newIntegrationClient(t)
 
 	// Create a sample workflow
 	newWorkflow := &CreateWorkflowRequest{
@@ -56,7 +56,7 @@ func TestIntegrationCreateWorkflow(t *testing.T) {
 				Name:        "Start",
 				Type:        "n8n-nodes-base.start",
 				TypeVersion: 1,
-				Position:    []int{0, 0},
+				Position:    []float64{0, 0},
 				Parameters:  map[string]interface{}{},
 			},
 		},
@@ -81,14 +81,7 @@ func TestIntegrationCreateWorkflow(t *testing.T) {
 }
 
 func TestIntegrationCreateWorkflowWithMultiNodeConnections(t *testing.T) {
-	// Start the n8n container for testing
-	container, url, err := helpers.CreateTestContainer()
-	require.NoError(t, err)
-
-	defer helpers.DeferTerminate(container)()
-
-	client, err := NewClient(&url, &config.ApiToken)
-	require.NoError(t, err)
+	client := newIntegrationClient(t)
 
 	// Define a multi-node workflow
 	newWorkflow := &CreateWorkflowRequest{
@@ -99,7 +92,7 @@ func TestIntegrationCreateWorkflowWithMultiNodeConnections(t *testing.T) {
 				Name:        "Start",
 				Type:        "n8n-nodes-base.start",
 				TypeVersion: 1,
-				Position:    []int{0, 0},
+				Position:    []float64{0, 0},
 				Parameters:  map[string]interface{}{},
 			},
 			{
@@ -107,7 +100,7 @@ func TestIntegrationCreateWorkflowWithMultiNodeConnections(t *testing.T) {
 				Name:        "HTTP Request",
 				Type:        "n8n-nodes-base.httpRequest",
 				TypeVersion: 1,
-				Position:    []int{300, 0},
+				Position:    []float64{300, 0},
 				Parameters: map[string]interface{}{
 					"url":    "https://example.com",
 					"method": "GET",
@@ -118,7 +111,7 @@ func TestIntegrationCreateWorkflowWithMultiNodeConnections(t *testing.T) {
 				Name:        "Set",
 				Type:        "n8n-nodes-base.set",
 				TypeVersion: 1,
-				Position:    []int{600, 0},
+				Position:    []float64{600, 0},
 				Parameters: map[string]interface{}{
 					"values": map[string]interface{}{
 						"string": []map[string]interface{}{
@@ -157,14 +150,7 @@ func TestIntegrationCreateWorkflowWithMultiNodeConnections(t *testing.T) {
 }
 
 func TestIntegrationUpdateWorkflow(t *testing.T) {
-	// Start the n8n container for testing
-	container, url, err := helpers.CreateTestContainer()
-	require.NoError(t, err)
-
-	defer helpers.DeferTerminate(container)()
-
-	client, err := NewClient(&url, &config.ApiToken)
-	require.NoError(t, err)
+	client := newIntegrationClient(t)
 
 	initialWorkflow := &CreateWorkflowRequest{
 		Name: "Original Workflow",
@@ -174,7 +160,7 @@ func TestIntegrationUpdateWorkflow(t *testing.T) {
 				Name:        "Start",
 				Type:        "n8n-nodes-base.start",
 				TypeVersion: 1,
-				Position:    []int{0, 0},
+				Position:    []float64{0, 0},
 				Parameters:  map[string]interface{}{},
 			},
 		},
@@ -202,7 +188,7 @@ func TestIntegrationUpdateWorkflow(t *testing.T) {
 				Name:        "Start",
 				Type:        "n8n-nodes-base.start",
 				TypeVersion: 1,
-				Position:    []int{0, 0},
+				Position:    []float64{0, 0},
 				Parameters:  map[string]interface{}{},
 			},
 			{
@@ -210,7 +196,7 @@ func TestIntegrationUpdateWorkflow(t *testing.T) {
 				Name:        "Set Node",
 				Type:        "n8n-nodes-base.set",
 				TypeVersion: 1,
-				Position:    []int{300, 0},
+				Position:    []float64{300, 0},
 				Parameters: map[string]interface{}{
 					"values": map[string]interface{}{
 						"string": []map[string]interface{}{
@@ -245,13 +231,7 @@ func TestIntegrationUpdateWorkflow(t *testing.T) {
 }
 
 func TestIntegrationDeleteWorkflow(t *testing.T) {
-	container, url, err := helpers.CreateTestContainer()
-	require.NoError(t, err)
-
-	defer helpers.DeferTerminate(container)()
-
-	client, err := NewClient(&url, &config.ApiToken)
-	require.NoError(t, err)
+	client := newIntegrationClient(t)
 
 	// Create a workflow to delete
 	newWorkflow := &CreateWorkflowRequest{
@@ -261,7 +241,7 @@ func TestIntegrationDeleteWorkflow(t *testing.T) {
 			Name:        "Start",
 			Type:        "n8n-nodes-base.start",
 			TypeVersion: 1,
-			Position:    []int{0, 0},
+			Position:    []float64{0, 0},
 			Parameters:  map[string]interface{}{},
 		}},
 		Connections: map[string]Connection{},
@@ -281,13 +261,7 @@ func TestIntegrationDeleteWorkflow(t *testing.T) {
 }
 
 func TestIntegrationActivateDeactivateWorkflow(t *testing.T) {
-	container, url, err := helpers.CreateTestContainer()
-	require.NoError(t, err)
-
-	defer helpers.DeferTerminate(container)()
-
-	client, err := NewClient(&url, &config.ApiToken)
-	require.NoError(t, err)
+	client := newIntegrationClient(t)
 
 	// Create workflow
 	newWorkflow := &CreateWorkflowRequest{
@@ -297,7 +271,7 @@ func TestIntegrationActivateDeactivateWorkflow(t *testing.T) {
 			Name:        "Schedule Trigger",
 			Type:        "n8n-nodes-base.scheduleTrigger",
 			TypeVersion: 1,
-			Position:    []int{0, 0},
+			Position:    []float64{0, 0},
 			Parameters: map[string]interface{}{
 				"rule": map[string]interface{}{
 					"interval": []interface{}{
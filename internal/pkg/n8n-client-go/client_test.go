@@ -208,3 +208,68 @@ func TestDoRequest_Non200StatusCode(t *testing.T) {
 		t.Errorf("expected body to be nil on non-200 response")
 	}
 }
+
+func TestDoRequest_NotFound(t *testing.T) {
+	client := newMockClient(func(req *http.Request) (*http.Response, error) {
+		return &http.Response{
+			StatusCode: http.StatusNotFound,
+			Body:       io.NopCloser(strings.NewReader(`{"message":"Not Found"}`)),
+		}, nil
+	})
+
+	req, _ := http.NewRequest("GET", client.HostURL+"/test", nil)
+
+	_, err := client.doRequest(req)
+	if !IsNotFound(err) {
+		t.Fatalf("expected not found error, got: %v", err)
+	}
+
+	if IsNotFound(errors.New("status: 404")) {
+		t.Errorf("expected plain errors not to be reported as not found")
+	}
+}
+
+func TestDoRequest_UserAgent(t *testing.T) {
+	tests := map[string]struct {
+		opts     []ClientOption
+		expected string
+	}{
+		"default": {
+			expected: DefaultUserAgent,
+		},
+		"custom": {
+			opts:     []ClientOption{WithUserAgent("terraform-provider-n8n/1.0.0 (terraform 1.9.0)")},
+			expected: "terraform-provider-n8n/1.0.0 (terraform 1.9.0)",
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			var userAgent string
+			ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				userAgent = r.Header.Get("User-Agent")
+				w.WriteHeader(http.StatusOK)
+			}))
+			defer ts.Close()
+
+			token := "test-token"
+			client, err := NewClient(&ts.URL, &token, tc.opts...)
+			if err != nil {
+				t.Fatalf("failed to create client: %v", err)
+			}
+
+			req, err := http.NewRequest("GET", client.HostURL+"/test", nil)
+			if err != nil {
+				t.Fatalf("failed to create request: %v", err)
+			}
+
+			if _, err := client.doRequest(req); err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			if userAgent != tc.expected {
+				t.Errorf("expected User-Agent %q, got %q", tc.expected, userAgent)
+			}
+		})
+	}
+}
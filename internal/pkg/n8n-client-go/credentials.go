@@ -0,0 +1,83 @@
+// Copyright (c) Arthur Diniz <arthurbdiniz@gmail.com>
+// SPDX-License-Identifier: Apache-2.0
+
+package n8n
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+)
+
+// Credential describes a credential stored in n8n. The secret data of a
+// credential is never returned by the API.
+type Credential struct {
+	// ID is the unique identifier of the credential.
+	ID string `json:"id"`
+
+	// Name is the name of the credential.
+	Name string `json:"name"`
+
+	// Type is the credential type, such as httpHeaderAuth.
+	Type string `json:"type"`
+
+	// CreatedAt is the timestamp when the credential was created.
+	CreatedAt string `json:"createdAt"`
+
+	// UpdatedAt is the timestamp when the credential was last updated.
+	UpdatedAt string `json:"updatedAt"`
+}
+
+// CredentialsResponse represents a paginated response from an API call
+// that returns a list of credentials.
+type CredentialsResponse struct {
+	// Data contains the list of credentials returned in the response.
+	Data []Credential `json:"data"`
+
+	// NextCursor is the cursor of the next page, or nil on the last page.
+	NextCursor *string `json:"nextCursor"`
+}
+
+// GetCredentials retrieves all credentials from your n8n instance, without
+// their data. Like GetWorkflows, it automatically iterates through all
+// available pages. Versions of n8n whose public API cannot list credentials
+// answer with a not found or method not allowed error.
+//
+// Returns a pointer to a CredentialsResponse containing all credentials,
+// or an error if the request or response decoding fails.
+func (c *Client) GetCredentials() (*CredentialsResponse, error) {
+	var allCredentials CredentialsResponse
+	query := url.Values{}
+
+	for {
+		endpoint := fmt.Sprintf("%s/api/v1/credentials", c.HostURL)
+		if len(query) > 0 {
+			endpoint = fmt.Sprintf("%s?%s", endpoint, query.Encode())
+		}
+
+		req, err := http.NewRequestWithContext(c.context(), "GET", endpoint, nil)
+		if err != nil {
+			return nil, err
+		}
+
+		body, err := c.doRequest(req)
+		if err != nil {
+			return nil, err
+		}
+
+		var credentials CredentialsResponse
+		err = json.Unmarshal(body, &credentials)
+		if err != nil {
+			return nil, err
+		}
+
+		allCredentials.Data = append(allCredentials.Data, credentials.Data...)
+		if credentials.NextCursor == nil || *credentials.NextCursor == "" {
+			break
+		}
+		query.Set("cursor", *credentials.NextCursor)
+	}
+
+	return &allCredentials, nil
+}
@@ -0,0 +1,29 @@
+// Copyright (c) Arthur Diniz <arthurbdiniz@gmail.com>
+// SPDX-License-Identifier: Apache-2.0
+
+package n8n
+
+import (
+	"testing"
+
+	"github.com/arthurbdiniz/terraform-provider-n8n/internal/pkg/n8n-client-go/n8ntest"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetCredentials(t *testing.T) {
+	server := n8ntest.NewServer(t, "test-token")
+	server.PageSize = 2
+	for _, name := range []string{"Slack", "GitHub", "Postgres"} {
+		server.AddCredential(n8ntest.Credential{Name: name, Type: "api", Data: map[string]interface{}{"token": "secret"}})
+	}
+
+	client, err := NewClient(&server.URL, &server.APIKey)
+	require.NoError(t, err)
+
+	credentials, err := client.GetCredentials()
+	require.NoError(t, err)
+	require.Len(t, credentials.Data, 3)
+	require.Equal(t, "Postgres", credentials.Data[2].Name)
+	require.Equal(t, "api", credentials.Data[2].Type)
+	require.NotEmpty(t, credentials.Data[2].ID)
+}
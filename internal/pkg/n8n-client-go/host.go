@@ -0,0 +1,70 @@
+// Copyright (c) Arthur Diniz <arthurbdiniz@gmail.com>
+// SPDX-License-Identifier: Apache-2.0
+
+package n8n
+
+import (
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+)
+
+// apiPath is the path of the public API below the n8n base URL.
+const apiPath = "/api/v1"
+
+// NormalizeHostURL validates the base URL of an n8n instance and returns it in
+// the form the client expects: an http or https URL without trailing slashes.
+// A path prefix is kept for instances served under a subpath, while a
+// trailing /api/v1 is removed since the client adds it to every request.
+//
+// Example:
+//
+//	host, err := n8n.NormalizeHostURL("https://example.com/n8n/api/v1/")
+//	// host == "https://example.com/n8n"
+func NormalizeHostURL(host string) (string, error) {
+	host = strings.TrimSpace(host)
+	if host == "" {
+		return "", fmt.Errorf("host is required")
+	}
+
+	u, err := url.Parse(host)
+	if err != nil {
+		return "", fmt.Errorf("host %q is not a valid URL: %w", host, err)
+	}
+
+	if u.Scheme != "http" && u.Scheme != "https" {
+		return "", fmt.Errorf("host %q must start with http:// or https://", host)
+	}
+	if u.Host == "" {
+		return "", fmt.Errorf("host %q has no hostname", host)
+	}
+	if u.User != nil {
+		return "", fmt.Errorf("host %q must not contain credentials, use the token instead", host)
+	}
+	if u.RawQuery != "" || u.Fragment != "" {
+		return "", fmt.Errorf("host %q must not contain a query or fragment", host)
+	}
+
+	path := strings.TrimRight(u.EscapedPath(), "/")
+	path = strings.TrimSuffix(path, apiPath)
+	path = strings.TrimRight(path, "/")
+
+	return u.Scheme + "://" + u.Host + path, nil
+}
+
+// VerifyConnection makes a cheap authenticated request, listing a single
+// workflow, to check that the host is reachable and the token is accepted.
+//
+// Returns an *APIError when n8n rejects the request, for example with status
+// 401 for an invalid token, or the transport error when the host cannot be
+// reached.
+func (c *Client) VerifyConnection() error {
+	req, err := http.NewRequestWithContext(c.context(), "GET", fmt.Sprintf("%s/api/v1/workflows?limit=1", c.HostURL), nil)
+	if err != nil {
+		return err
+	}
+
+	_, err = c.doRequest(req)
+	return err
+}
@@ -0,0 +1,62 @@
+// Copyright (c) Arthur Diniz <arthurbdiniz@gmail.com>
+// SPDX-License-Identifier: Apache-2.0
+
+package n8n
+
+import (
+	"encoding/json"
+	"reflect"
+	"strings"
+)
+
+// jsonFieldNames returns the JSON keys of the exported, tagged fields of the given struct type.
+func jsonFieldNames(t reflect.Type) map[string]struct{} {
+	names := make(map[string]struct{}, t.NumField())
+	for i := 0; i < t.NumField(); i++ {
+		tag := t.Field(i).Tag.Get("json")
+		name := strings.Split(tag, ",")[0]
+		if name == "" || name == "-" {
+			continue
+		}
+		names[name] = struct{}{}
+	}
+	return names
+}
+
+// extraFields returns the top-level keys of the JSON object that are not
+// mapped to a field of the given struct type, or nil when there are none.
+func extraFields(data []byte, t reflect.Type) (map[string]json.RawMessage, error) {
+	var raw map[string]json.RawMessage
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return nil, err
+	}
+
+	for name := range jsonFieldNames(t) {
+		delete(raw, name)
+	}
+
+	if len(raw) == 0 {
+		return nil, nil
+	}
+	return raw, nil
+}
+
+// withExtraFields merges the extra keys into the encoded JSON object.
+// Keys already present in the object take precedence.
+func withExtraFields(data []byte, extra map[string]json.RawMessage) ([]byte, error) {
+	if len(extra) == 0 {
+		return data, nil
+	}
+
+	var raw map[string]json.RawMessage
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return nil, err
+	}
+
+	for k, v := range extra {
+		if _, ok := raw[k]; !ok {
+			raw[k] = v
+		}
+	}
+	return json.Marshal(raw)
+}
@@ -0,0 +1,146 @@
+// Copyright (c) Arthur Diniz <arthurbdiniz@gmail.com>
+// SPDX-License-Identifier: Apache-2.0
+
+package n8n
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"time"
+)
+
+// Logger receives a debug record for every request made by the client, with
+// the context of the request, see Client.WithContext. Fields never contain
+// the API key or credential data.
+type Logger interface {
+	Debug(ctx context.Context, msg string, fields map[string]interface{})
+}
+
+// WithLogger sets a Logger that receives the method, URL, status, duration
+// and truncated bodies of every request.
+func WithLogger(logger Logger) ClientOption {
+	return func(c *Client) {
+		c.Logger = logger
+	}
+}
+
+const (
+	// MaxLoggedBodyBytes is the number of body bytes included in log records.
+	MaxLoggedBodyBytes = 4096
+
+	redacted = "[REDACTED]"
+)
+
+// readRequestBody returns a copy of the request body for logging without
+// consuming it.
+func readRequestBody(req *http.Request) []byte {
+	if req.GetBody == nil {
+		return nil
+	}
+
+	body, err := req.GetBody()
+	if err != nil {
+		return nil
+	}
+	defer body.Close()
+
+	data, _ := io.ReadAll(body)
+	return data
+}
+
+// logRequest emits a debug record for a completed request. res and
+// responseBody are nil when the request failed before a response arrived.
+func (c *Client) logRequest(req *http.Request, requestBody []byte, res *http.Response, responseBody []byte, duration time.Duration, err error) {
+	if c.Logger == nil {
+		return
+	}
+
+	fields := map[string]interface{}{
+		"method":          req.Method,
+		"url":             req.URL.String(),
+		"duration_ms":     duration.Milliseconds(),
+		"request_headers": redactHeaders(req.Header),
+	}
+
+	if len(requestBody) > 0 {
+		fields["request_body"] = formatLoggedBody(req.URL.Path, requestBody)
+	}
+
+	if res != nil {
+		fields["status"] = res.StatusCode
+		fields["response_body"] = formatLoggedBody(req.URL.Path, responseBody)
+	}
+
+	if err != nil {
+		fields["error"] = err.Error()
+	}
+
+	c.Logger.Debug(req.Context(), "n8n API request", fields)
+}
+
+func redactHeaders(header http.Header) map[string]string {
+	headers := make(map[string]string, len(header))
+	for key, values := range header {
+		value := strings.Join(values, ", ")
+		if http.CanonicalHeaderKey(key) == "X-N8n-Api-Key" {
+			value = redacted
+		}
+		headers[key] = value
+	}
+	return headers
+}
+
+// formatLoggedBody redacts credential data from bodies of credential endpoints
+// and truncates the result to MaxLoggedBodyBytes.
+func formatLoggedBody(path string, body []byte) string {
+	if strings.Contains(path, "/credentials") {
+		body = redactCredentialData(body)
+	}
+
+	if len(body) > MaxLoggedBodyBytes {
+		return fmt.Sprintf("%s... (%d more bytes)", body[:MaxLoggedBodyBytes], len(body)-MaxLoggedBodyBytes)
+	}
+	return string(body)
+}
+
+// redactCredentialData replaces every "data" member of a JSON document with a
+// placeholder. Bodies that are not valid JSON are redacted entirely, since
+// they cannot be inspected safely.
+func redactCredentialData(body []byte) []byte {
+	if len(body) == 0 {
+		return body
+	}
+
+	var document interface{}
+	if err := json.Unmarshal(body, &document); err != nil {
+		return []byte(redacted)
+	}
+
+	redactedBody, err := json.Marshal(redactDataMembers(document))
+	if err != nil {
+		return []byte(redacted)
+	}
+	return redactedBody
+}
+
+func redactDataMembers(value interface{}) interface{} {
+	switch val := value.(type) {
+	case map[string]interface{}:
+		for key, member := range val {
+			if key == "data" {
+				val[key] = redacted
+				continue
+			}
+			val[key] = redactDataMembers(member)
+		}
+	case []interface{}:
+		for i, item := range val {
+			val[i] = redactDataMembers(item)
+		}
+	}
+	return value
+}
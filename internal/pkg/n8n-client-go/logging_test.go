@@ -0,0 +1,217 @@
+// Copyright (c) Arthur Diniz <arthurbdiniz@gmail.com>
+// SPDX-License-Identifier: Apache-2.0
+
+package n8n
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+)
+
+type recordingLogger struct {
+	contexts []context.Context
+	messages []string
+	fields   []map[string]interface{}
+}
+
+func (l *recordingLogger) Debug(ctx context.Context, msg string, fields map[string]interface{}) {
+	l.contexts = append(l.contexts, ctx)
+	l.messages = append(l.messages, msg)
+	l.fields = append(l.fields, fields)
+}
+
+func TestDoRequest_Logger(t *testing.T) {
+	logger := &recordingLogger{}
+	client := newMockClient(func(req *http.Request) (*http.Response, error) {
+		return &http.Response{
+			StatusCode: http.StatusOK,
+			Body:       io.NopCloser(strings.NewReader(`{"id":"1","name":"Example"}`)),
+		}, nil
+	})
+	client.Logger = logger
+
+	req, err := http.NewRequest(http.MethodPost, client.HostURL+"/api/v1/workflows?limit=1", bytes.NewReader([]byte(`{"name":"Example"}`)))
+	if err != nil {
+		t.Fatalf("failed to create request: %v", err)
+	}
+
+	body, err := client.doRequest(req)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if string(body) != `{"id":"1","name":"Example"}` {
+		t.Errorf("expected response body to be returned unchanged, got %s", body)
+	}
+
+	if len(logger.fields) != 1 {
+		t.Fatalf("expected 1 log record, got %d", len(logger.fields))
+	}
+
+	fields := logger.fields[0]
+	expected := map[string]interface{}{
+		"method":        http.MethodPost,
+		"url":           "http://example.com/api/v1/workflows?limit=1",
+		"status":        http.StatusOK,
+		"request_body":  `{"name":"Example"}`,
+		"response_body": `{"id":"1","name":"Example"}`,
+	}
+	for key, value := range expected {
+		if fields[key] != value {
+			t.Errorf("expected %s %v, got %v", key, value, fields[key])
+		}
+	}
+	if _, ok := fields["duration_ms"]; !ok {
+		t.Errorf("expected duration_ms to be logged")
+	}
+
+	headers, ok := fields["request_headers"].(map[string]string)
+	if !ok {
+		t.Fatalf("expected request_headers to be a map, got %T", fields["request_headers"])
+	}
+	if headers["X-N8n-Api-Key"] != "[REDACTED]" {
+		t.Errorf("expected API key header to be redacted, got %q", headers["X-N8n-Api-Key"])
+	}
+	for key, value := range fields {
+		if strings.Contains(strings.ToLower(key+" "+stringify(value)), "test-token") {
+			t.Errorf("expected token not to be logged, found it in %s", key)
+		}
+	}
+}
+
+func TestDoRequest_LoggerTransportError(t *testing.T) {
+	logger := &recordingLogger{}
+	client := newMockClient(func(req *http.Request) (*http.Response, error) {
+		return nil, errors.New("connection refused")
+	})
+	client.Logger = logger
+
+	req, err := http.NewRequest(http.MethodGet, client.HostURL+"/api/v1/workflows", nil)
+	if err != nil {
+		t.Fatalf("failed to create request: %v", err)
+	}
+
+	if _, err := client.doRequest(req); err == nil {
+		t.Fatalf("expected an error")
+	}
+
+	if len(logger.fields) != 1 {
+		t.Fatalf("expected 1 log record, got %d", len(logger.fields))
+	}
+	if _, ok := logger.fields[0]["status"]; ok {
+		t.Errorf("expected no status without a response")
+	}
+	if errorField, _ := logger.fields[0]["error"].(string); !strings.Contains(errorField, "connection refused") {
+		t.Errorf("expected transport error to be logged, got %q", errorField)
+	}
+}
+
+func TestWithContext_Logger(t *testing.T) {
+	type operationKey struct{}
+
+	logger := &recordingLogger{}
+	client := newMockClient(func(req *http.Request) (*http.Response, error) {
+		return &http.Response{
+			StatusCode: http.StatusOK,
+			Body:       io.NopCloser(strings.NewReader(`{"id":"1","name":"Example"}`)),
+		}, nil
+	})
+	client.Logger = logger
+
+	first := context.WithValue(context.Background(), operationKey{}, "first")
+	second := context.WithValue(context.Background(), operationKey{}, "second")
+
+	if _, err := client.WithContext(first).GetWorkflow("1"); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if _, err := client.WithContext(second).GetWorkflow("1"); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if _, err := client.GetWorkflow("1"); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if len(logger.contexts) != 3 {
+		t.Fatalf("expected 3 log records, got %d", len(logger.contexts))
+	}
+	for i, expected := range []interface{}{"first", "second", nil} {
+		if value := logger.contexts[i].Value(operationKey{}); value != expected {
+			t.Errorf("expected record %d to be logged with the context of its request %v, got %v", i, expected, value)
+		}
+	}
+	if client.ctx != nil {
+		t.Errorf("expected WithContext to leave the client unchanged")
+	}
+}
+
+func TestFormatLoggedBody(t *testing.T) {
+	tests := map[string]struct {
+		path     string
+		body     string
+		expected string
+	}{
+		"workflow body is kept": {
+			path:     "/api/v1/workflows/1",
+			body:     `{"data":"kept"}`,
+			expected: `{"data":"kept"}`,
+		},
+		"credential data is redacted": {
+			path:     "/api/v1/credentials",
+			body:     `{"name":"Slack","type":"slackApi","data":{"accessToken":"secret"}}`,
+			expected: `{"data":"[REDACTED]","name":"Slack","type":"slackApi"}`,
+		},
+		"nested credential data is redacted": {
+			path:     "/api/v1/credentials",
+			body:     `{"data":[{"id":"1","data":{"password":"secret"}}]}`,
+			expected: `{"data":"[REDACTED]"}`,
+		},
+		"invalid credential body is redacted": {
+			path:     "/api/v1/credentials",
+			body:     `password=secret`,
+			expected: `[REDACTED]`,
+		},
+		"empty body": {
+			path:     "/api/v1/credentials/1",
+			body:     ``,
+			expected: ``,
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			got := formatLoggedBody(tc.path, []byte(tc.body))
+			if got != tc.expected {
+				t.Errorf("expected %q, got %q", tc.expected, got)
+			}
+		})
+	}
+}
+
+func TestFormatLoggedBody_Truncates(t *testing.T) {
+	body := strings.Repeat("a", MaxLoggedBodyBytes+10)
+
+	got := formatLoggedBody("/api/v1/workflows", []byte(body))
+
+	expected := strings.Repeat("a", MaxLoggedBodyBytes) + "... (10 more bytes)"
+	if got != expected {
+		t.Errorf("expected body to be truncated to %d bytes, got %d bytes", MaxLoggedBodyBytes, len(got))
+	}
+}
+
+func stringify(value interface{}) string {
+	switch val := value.(type) {
+	case string:
+		return val
+	case map[string]string:
+		var b strings.Builder
+		for key, v := range val {
+			b.WriteString(key + "=" + v + " ")
+		}
+		return b.String()
+	}
+	return ""
+}
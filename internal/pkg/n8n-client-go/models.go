@@ -3,7 +3,10 @@
 
 package n8n
 
-import "encoding/json"
+import (
+	"encoding/json"
+	"reflect"
+)
 
 // Workflow represents a workflow in n8n, including metadata, configuration,
 // nodes, connections, and tags.
@@ -41,8 +44,17 @@ type Workflow struct {
 
 	// Tags is a list of tags associated with the workflow for categorization.
 	Tags []Tag `json:"tags"`
-	// PinData      interface{}           `json:"pinData"`  // TODO understand how this parameter is used and make it exportable to the state
-	// StaticData   interface{}           `json:"staticData"` // TODO understand how this parameter is used and make it exportable to the state
+
+	// PinData holds mocked node output data, keyed by node name, that n8n
+	// uses instead of running the node during manual executions.
+	PinData json.RawMessage `json:"pinData,omitempty"`
+
+	// StaticData holds data persisted by the workflow between executions,
+	// such as the last poll time of a trigger node.
+	StaticData json.RawMessage `json:"staticData,omitempty"`
+
+	// Meta holds editor metadata, such as the template the workflow was created from.
+	Meta json.RawMessage `json:"meta,omitempty"`
 }
 
 // WorkflowsResponse represents a paginated response from an API call
@@ -76,7 +88,39 @@ type Tag struct {
 type Connection struct {
 	// Main holds the raw connection data. It should be further structured for improved type safety.
 	// TODO: Find a way to transform this into a concrete struct.
-	Main json.RawMessage `json:"main"`
+	Main json.RawMessage `json:"main,omitempty"`
+
+	// Extra holds the connections of any other output type, such as
+	// ai_languageModel or ai_tool, keyed by output type.
+	Extra map[string]json.RawMessage `json:"-"`
+}
+
+type connectionAlias Connection
+
+// UnmarshalJSON decodes a connection, keeping output types other than main in Extra.
+func (c *Connection) UnmarshalJSON(data []byte) error {
+	var alias connectionAlias
+	if err := json.Unmarshal(data, &alias); err != nil {
+		return err
+	}
+
+	extra, err := extraFields(data, reflect.TypeOf(alias))
+	if err != nil {
+		return err
+	}
+
+	*c = Connection(alias)
+	c.Extra = extra
+	return nil
+}
+
+// MarshalJSON encodes a connection, including the output types held in Extra.
+func (c Connection) MarshalJSON() ([]byte, error) {
+	data, err := json.Marshal(connectionAlias(c))
+	if err != nil {
+		return nil, err
+	}
+	return withExtraFields(data, c.Extra)
 }
 
 // ConnectionDetail provides detailed information about a specific connection between nodes.
@@ -103,13 +147,69 @@ type Node struct {
 	TypeVersion float64 `json:"typeVersion"`
 
 	// Position is the visual location of the node on the workflow canvas.
-	Position []int `json:"position"`
+	// It is usually made of integers, but n8n keeps the fractional
+	// coordinates of nodes placed by some editor versions.
+	Position []float64 `json:"position"`
 
 	// ID is the unique identifier of the node.
 	ID string `json:"id"`
 
 	// Name is the user-defined name of the node.
 	Name string `json:"name"`
+
+	// Credentials maps the credential types used by the node, such as
+	// httpHeaderAuth, to the credential selected for each.
+	Credentials map[string]CredentialRef `json:"credentials,omitempty"`
+
+	// WebhookID identifies the webhooks registered by trigger nodes such as
+	// Webhook and Form Trigger.
+	WebhookID string `json:"webhookId,omitempty"`
+
+	// Disabled is true for nodes skipped when the workflow runs.
+	Disabled bool `json:"disabled,omitempty"`
+
+	// Extra holds any other node properties returned by n8n or present in an
+	// exported workflow, such as notes or retryOnFail, so they are preserved
+	// when the node is sent back.
+	Extra map[string]json.RawMessage `json:"-"`
+}
+
+// CredentialRef references a credential from a node. The ID is specific to
+// an n8n instance, while the name usually is the same across instances.
+type CredentialRef struct {
+	// ID is the unique identifier of the credential.
+	ID string `json:"id,omitempty"`
+
+	// Name is the name of the credential.
+	Name string `json:"name"`
+}
+
+type nodeAlias Node
+
+// UnmarshalJSON decodes a node, keeping properties without a dedicated field in Extra.
+func (n *Node) UnmarshalJSON(data []byte) error {
+	var alias nodeAlias
+	if err := json.Unmarshal(data, &alias); err != nil {
+		return err
+	}
+
+	extra, err := extraFields(data, reflect.TypeOf(alias))
+	if err != nil {
+		return err
+	}
+
+	*n = Node(alias)
+	n.Extra = extra
+	return nil
+}
+
+// MarshalJSON encodes a node, including the properties held in Extra.
+func (n Node) MarshalJSON() ([]byte, error) {
+	data, err := json.Marshal(nodeAlias(n))
+	if err != nil {
+		return nil, err
+	}
+	return withExtraFields(data, n.Extra)
 }
 
 // Settings contains global execution settings for a workflow.
@@ -122,6 +222,38 @@ type Settings struct {
 	ErrorWorkflow            string `json:"errorWorkflow"`
 	Timezone                 string `json:"timezone"`
 	ExecutionOrder           string `json:"executionOrder"`
+
+	// Extra holds any other settings returned by n8n, such as callerPolicy,
+	// so they are preserved when the settings are sent back.
+	Extra map[string]json.RawMessage `json:"-"`
+}
+
+type settingsAlias Settings
+
+// UnmarshalJSON decodes workflow settings, keeping settings without a dedicated field in Extra.
+func (s *Settings) UnmarshalJSON(data []byte) error {
+	var alias settingsAlias
+	if err := json.Unmarshal(data, &alias); err != nil {
+		return err
+	}
+
+	extra, err := extraFields(data, reflect.TypeOf(alias))
+	if err != nil {
+		return err
+	}
+
+	*s = Settings(alias)
+	s.Extra = extra
+	return nil
+}
+
+// MarshalJSON encodes workflow settings, including the settings held in Extra.
+func (s Settings) MarshalJSON() ([]byte, error) {
+	data, err := json.Marshal(settingsAlias(s))
+	if err != nil {
+		return nil, err
+	}
+	return withExtraFields(data, s.Extra)
 }
 
 // CreateWorkflowRequest defines the allowed fields when creating a workflow.
@@ -130,7 +262,8 @@ type CreateWorkflowRequest struct {
 	Nodes       []Node                `json:"nodes"`
 	Connections map[string]Connection `json:"connections"`
 	Settings    Settings              `json:"settings"`
-	// StaticData   interface{}           `json:"staticData"` // TODO understand how this parameter is used and make it exportable to the state
+	PinData     json.RawMessage       `json:"pinData,omitempty"`
+	StaticData  json.RawMessage       `json:"staticData,omitempty"`
 }
 
 // UpdateWorkflowRequest defines the allowed fields when updating a workflow.
@@ -139,5 +272,6 @@ type UpdateWorkflowRequest struct {
 	Nodes       []Node                `json:"nodes"`
 	Connections map[string]Connection `json:"connections"`
 	Settings    Settings              `json:"settings"`
-	// StaticData   interface{}           `json:"staticData"` // TODO understand how this parameter is used and make it exportable to the state
+	PinData     json.RawMessage       `json:"pinData,omitempty"`
+	StaticData  json.RawMessage       `json:"staticData,omitempty"`
 }
@@ -0,0 +1,122 @@
+// Copyright (c) Arthur Diniz <arthurbdiniz@gmail.com>
+// SPDX-License-Identifier: Apache-2.0
+
+package n8n
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestNodeJSONRoundTrip(t *testing.T) {
+	input := `{
+		"id": "1",
+		"name": "Webhook",
+		"type": "n8n-nodes-base.webhook",
+		"typeVersion": 2,
+		"position": [0, 0],
+		"parameters": {"path": "hook"},
+		"webhookId": "0c6d8b64-3d4c-4e5b-9d45-3b0e3b7d2a11",
+		"disabled": true,
+		"notes": "Receives orders",
+		"credentials": {"httpHeaderAuth": {"id": "5", "name": "Header Auth"}}
+	}`
+
+	var node Node
+	require.NoError(t, json.Unmarshal([]byte(input), &node))
+
+	require.Equal(t, "Webhook", node.Name)
+	require.Equal(t, map[string]CredentialRef{"httpHeaderAuth": {ID: "5", Name: "Header Auth"}}, node.Credentials)
+	require.Equal(t, "0c6d8b64-3d4c-4e5b-9d45-3b0e3b7d2a11", node.WebhookID)
+	require.True(t, node.Disabled)
+	require.Len(t, node.Extra, 1)
+	require.JSONEq(t, `"Receives orders"`, string(node.Extra["notes"]))
+
+	output, err := json.Marshal(node)
+	require.NoError(t, err)
+	require.JSONEq(t, input, string(output))
+}
+
+func TestNodeJSONFractionalPosition(t *testing.T) {
+	var node Node
+	require.NoError(t, json.Unmarshal([]byte(`{"name": "Set", "position": [240.5, -80]}`), &node))
+	require.Equal(t, []float64{240.5, -80}, node.Position)
+
+	output, err := json.Marshal(node)
+	require.NoError(t, err)
+	require.Contains(t, string(output), `"position":[240.5,-80]`)
+}
+
+func TestNodeJSONWithoutExtra(t *testing.T) {
+	var node Node
+	require.NoError(t, json.Unmarshal([]byte(`{"id": "1", "name": "Start"}`), &node))
+	require.Nil(t, node.Extra)
+}
+
+func TestConnectionJSONRoundTrip(t *testing.T) {
+	input := `{
+		"main": [[{"node": "Set", "type": "main", "index": 0}]],
+		"ai_languageModel": [[{"node": "Agent", "type": "ai_languageModel", "index": 0}]]
+	}`
+
+	var connection Connection
+	require.NoError(t, json.Unmarshal([]byte(input), &connection))
+	require.JSONEq(t, `[[{"node": "Set", "type": "main", "index": 0}]]`, string(connection.Main))
+	require.Contains(t, connection.Extra, "ai_languageModel")
+
+	output, err := json.Marshal(connection)
+	require.NoError(t, err)
+	require.JSONEq(t, input, string(output))
+}
+
+func TestConnectionJSONWithoutMain(t *testing.T) {
+	output, err := json.Marshal(Connection{
+		Extra: map[string]json.RawMessage{
+			"ai_tool": json.RawMessage(`[[{"node":"Agent","type":"ai_tool","index":0}]]`),
+		},
+	})
+	require.NoError(t, err)
+	require.JSONEq(t, `{"ai_tool": [[{"node":"Agent","type":"ai_tool","index":0}]]}`, string(output))
+}
+
+func TestSettingsJSONRoundTrip(t *testing.T) {
+	input := `{
+		"saveExecutionProgress": true,
+		"saveManualExecutions": false,
+		"saveDataErrorExecution": "all",
+		"saveDataSuccessExecution": "none",
+		"executionOrder": "v1",
+		"errorWorkflow": "VzqKEW0ShTXA5vPj",
+		"timezone": "UTC",
+		"callerPolicy": "workflowsFromSameOwner",
+		"executionTimeout": 120
+	}`
+
+	var settings Settings
+	require.NoError(t, json.Unmarshal([]byte(input), &settings))
+
+	require.Equal(t, "VzqKEW0ShTXA5vPj", settings.ErrorWorkflow)
+	require.Len(t, settings.Extra, 1)
+	require.JSONEq(t, `"workflowsFromSameOwner"`, string(settings.Extra["callerPolicy"]))
+
+	output, err := json.Marshal(settings)
+	require.NoError(t, err)
+	require.JSONEq(t, input, string(output))
+}
+
+func TestSettingsJSONEncodesEmptyValues(t *testing.T) {
+	output, err := json.Marshal(Settings{ExecutionOrder: "v1"})
+	require.NoError(t, err)
+	require.JSONEq(t, `{
+		"saveExecutionProgress": false,
+		"saveManualExecutions": false,
+		"saveDataErrorExecution": "",
+		"saveDataSuccessExecution": "",
+		"executionTimeout": 0,
+		"errorWorkflow": "",
+		"timezone": "",
+		"executionOrder": "v1"
+	}`, string(output))
+}
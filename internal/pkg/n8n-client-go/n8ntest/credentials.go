@@ -0,0 +1,134 @@
+// Copyright (c) Arthur Diniz <arthurbdiniz@gmail.com>
+// SPDX-License-Identifier: Apache-2.0
+
+package n8ntest
+
+import (
+	"encoding/json"
+	"net/http"
+)
+
+// Credential is a credential as stored by the fake server. Like n8n, the
+// server never returns Data in its responses.
+type Credential struct {
+	ID        string                 `json:"id"`
+	Name      string                 `json:"name"`
+	Type      string                 `json:"type"`
+	Data      map[string]interface{} `json:"-"`
+	CreatedAt string                 `json:"createdAt"`
+	UpdatedAt string                 `json:"updatedAt"`
+}
+
+// AddCredential stores a credential directly and returns it with its ID and
+// timestamps filled in when empty.
+func (s *Server) AddCredential(credential Credential) Credential {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if credential.ID == "" {
+		credential.ID = s.newID()
+	}
+	if credential.CreatedAt == "" {
+		credential.CreatedAt = s.timestamp()
+	}
+	if credential.UpdatedAt == "" {
+		credential.UpdatedAt = credential.CreatedAt
+	}
+
+	s.credentials = append(s.credentials, &credential)
+	return credential
+}
+
+// Credentials returns every stored credential, including its data, in creation order.
+func (s *Server) Credentials() []Credential {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	credentials := make([]Credential, 0, len(s.credentials))
+	for _, credential := range s.credentials {
+		credentials = append(credentials, *credential)
+	}
+	return credentials
+}
+
+func (s *Server) registerCredentialRoutes(mux *http.ServeMux) {
+	mux.HandleFunc("GET /api/v1/credentials", s.listCredentials)
+	mux.HandleFunc("POST /api/v1/credentials", s.createCredential)
+	mux.HandleFunc("DELETE /api/v1/credentials/{id}", s.deleteCredential)
+}
+
+func (s *Server) listCredentials(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	start, end, next, err := s.page(r, len(s.credentials))
+	if err != nil {
+		writeError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	data := make([]Credential, 0, end-start)
+	for _, credential := range s.credentials[start:end] {
+		data = append(data, *credential)
+	}
+	writeJSON(w, http.StatusOK, listResponse{Data: data, NextCursor: next})
+}
+
+func (s *Server) createCredential(w http.ResponseWriter, r *http.Request) {
+	var body struct {
+		Name *string                `json:"name"`
+		Type *string                `json:"type"`
+		Data map[string]interface{} `json:"data"`
+	}
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		writeError(w, http.StatusBadRequest, "request/body must be object")
+		return
+	}
+	switch {
+	case body.Name == nil:
+		writeError(w, http.StatusBadRequest, "request/body must have required property 'name'")
+		return
+	case body.Type == nil:
+		writeError(w, http.StatusBadRequest, "request/body must have required property 'type'")
+		return
+	case body.Data == nil:
+		writeError(w, http.StatusBadRequest, "request/body must have required property 'data'")
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	now := s.timestamp()
+	credential := &Credential{
+		ID:        s.newID(),
+		Name:      *body.Name,
+		Type:      *body.Type,
+		Data:      body.Data,
+		CreatedAt: now,
+		UpdatedAt: now,
+	}
+	s.credentials = append(s.credentials, credential)
+
+	writeJSON(w, http.StatusOK, credential)
+}
+
+func (s *Server) deleteCredential(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	id := r.PathValue("id")
+	var credential *Credential
+	for _, candidate := range s.credentials {
+		if candidate.ID == id {
+			credential = candidate
+		}
+	}
+	if credential == nil {
+		writeNotFound(w)
+		return
+	}
+
+	s.credentials = removeFirst(s.credentials, func(candidate *Credential) bool { return candidate.ID == id })
+	writeJSON(w, http.StatusOK, credential)
+}
@@ -0,0 +1,142 @@
+// Copyright (c) Arthur Diniz <arthurbdiniz@gmail.com>
+// SPDX-License-Identifier: Apache-2.0
+
+package n8ntest
+
+import (
+	"encoding/json"
+	"net/http"
+	"strconv"
+)
+
+// Execution is a workflow execution as stored and returned by the fake server.
+// The public API does not create executions, so tests seed them with AddExecution.
+type Execution struct {
+	ID         int             `json:"id"`
+	WorkflowID string          `json:"workflowId"`
+	Finished   bool            `json:"finished"`
+	Mode       string          `json:"mode"`
+	Status     string          `json:"status"`
+	StartedAt  string          `json:"startedAt"`
+	StoppedAt  string          `json:"stoppedAt"`
+	Data       json.RawMessage `json:"data,omitempty"`
+}
+
+// AddExecution stores an execution and returns it with its ID, mode and
+// start time filled in when empty.
+func (s *Server) AddExecution(execution Execution) Execution {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if execution.ID == 0 {
+		s.nextID++
+		execution.ID = s.nextID
+	}
+	if execution.Mode == "" {
+		execution.Mode = "manual"
+	}
+	if execution.StartedAt == "" {
+		execution.StartedAt = s.timestamp()
+	}
+
+	s.executions = append(s.executions, &execution)
+	return execution
+}
+
+// Executions returns every stored execution in creation order.
+func (s *Server) Executions() []Execution {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	executions := make([]Execution, 0, len(s.executions))
+	for _, execution := range s.executions {
+		executions = append(executions, *execution)
+	}
+	return executions
+}
+
+func (s *Server) registerExecutionRoutes(mux *http.ServeMux) {
+	mux.HandleFunc("GET /api/v1/executions", s.listExecutions)
+	mux.HandleFunc("GET /api/v1/executions/{id}", s.getExecution)
+	mux.HandleFunc("DELETE /api/v1/executions/{id}", s.deleteExecution)
+}
+
+func (s *Server) listExecutions(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	query := r.URL.Query()
+	includeData := query.Get("includeData") == "true"
+
+	// n8n lists the most recent executions first.
+	var matches []Execution
+	for i := len(s.executions) - 1; i >= 0; i-- {
+		execution := *s.executions[i]
+		if workflowID := query.Get("workflowId"); workflowID != "" && execution.WorkflowID != workflowID {
+			continue
+		}
+		if status := query.Get("status"); status != "" && execution.Status != status {
+			continue
+		}
+		if !includeData {
+			execution.Data = nil
+		}
+		matches = append(matches, execution)
+	}
+
+	start, end, next, err := s.page(r, len(matches))
+	if err != nil {
+		writeError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	data := make([]Execution, 0, end-start)
+	data = append(data, matches[start:end]...)
+	writeJSON(w, http.StatusOK, listResponse{Data: data, NextCursor: next})
+}
+
+func (s *Server) getExecution(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	execution := s.findExecution(r.PathValue("id"))
+	if execution == nil {
+		writeNotFound(w)
+		return
+	}
+
+	rendered := *execution
+	if r.URL.Query().Get("includeData") != "true" {
+		rendered.Data = nil
+	}
+	writeJSON(w, http.StatusOK, rendered)
+}
+
+func (s *Server) deleteExecution(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	execution := s.findExecution(r.PathValue("id"))
+	if execution == nil {
+		writeNotFound(w)
+		return
+	}
+
+	s.executions = removeFirst(s.executions, func(candidate *Execution) bool { return candidate.ID == execution.ID })
+	writeJSON(w, http.StatusOK, execution)
+}
+
+// findExecution returns the stored execution with the given ID, or nil.
+// The caller must hold s.mu.
+func (s *Server) findExecution(id string) *Execution {
+	numericID, err := strconv.Atoi(id)
+	if err != nil {
+		return nil
+	}
+	for _, execution := range s.executions {
+		if execution.ID == numericID {
+			return execution
+		}
+	}
+	return nil
+}
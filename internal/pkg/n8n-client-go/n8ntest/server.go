@@ -0,0 +1,184 @@
+// Copyright (c) Arthur Diniz <arthurbdiniz@gmail.com>
+// SPDX-License-Identifier: Apache-2.0
+
+// Package n8ntest provides an in-memory fake of the n8n public API for tests.
+//
+// The fake serves workflows, tags, executions and credentials under /api/v1,
+// paginates list endpoints with opaque cursors, enforces the X-N8N-API-KEY
+// header and applies the same activation rules as n8n. It deliberately does
+// not import the client package, so the client's own tests can use it and
+// exercise the real request and response encoding.
+//
+// Example:
+//
+//	server := n8ntest.NewServer(t, "test-api-key")
+//	client, _ := n8n.NewClient(&server.URL, &server.APIKey)
+package n8ntest
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"sync"
+	"testing"
+	"time"
+)
+
+const (
+	// DefaultPageSize is the number of items returned per page when the
+	// request does not set a limit, matching n8n.
+	DefaultPageSize = 100
+
+	// MaxPageSize is the largest limit accepted by list endpoints.
+	MaxPageSize = 250
+
+	timeFormat = "2006-01-02T15:04:05.000Z"
+)
+
+// Server is an in-memory fake of the n8n public API backed by an httptest.Server.
+// All exported methods are safe for concurrent use.
+type Server struct {
+	*httptest.Server
+
+	// APIKey is the value expected in the X-N8N-API-KEY header.
+	APIKey string
+
+	// PageSize overrides the default page size of list endpoints when set.
+	// Lowering it is an easy way to exercise cursor pagination.
+	PageSize int
+
+	// Now returns the time used for createdAt and updatedAt fields.
+	Now func() time.Time
+
+	// CanActivate decides whether a workflow may be activated. It defaults
+	// to RequireTriggerNode.
+	CanActivate func(Workflow) error
+
+	mu          sync.Mutex
+	nextID      int
+	workflows   []*Workflow
+	tags        []*Tag
+	executions  []*Execution
+	credentials []*Credential
+}
+
+// NewServer starts a fake n8n server that accepts the given API key and
+// registers its shutdown with t.Cleanup.
+func NewServer(t testing.TB, apiKey string) *Server {
+	t.Helper()
+
+	s := &Server{
+		APIKey:      apiKey,
+		Now:         time.Now,
+		CanActivate: RequireTriggerNode,
+	}
+
+	mux := http.NewServeMux()
+	s.registerWorkflowRoutes(mux)
+	s.registerTagRoutes(mux)
+	s.registerExecutionRoutes(mux)
+	s.registerCredentialRoutes(mux)
+
+	s.Server = httptest.NewServer(s.authenticate(mux))
+	t.Cleanup(s.Close)
+
+	return s
+}
+
+// authenticate rejects requests without the expected API key the same way n8n does.
+func (s *Server) authenticate(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		key := r.Header.Get("X-N8N-API-KEY")
+		if key == "" {
+			writeError(w, http.StatusUnauthorized, "'X-N8N-API-KEY' header required")
+			return
+		}
+		if key != s.APIKey {
+			writeError(w, http.StatusUnauthorized, "unauthorized")
+			return
+		}
+		next.ServeHTTP(w, r)
+	})
+}
+
+// newID returns a unique 16 character identifier shaped like the ones n8n generates.
+// The caller must hold s.mu.
+func (s *Server) newID() string {
+	s.nextID++
+	return fmt.Sprintf("%016d", s.nextID)
+}
+
+// timestamp returns the current time formatted like n8n timestamps.
+// The caller must hold s.mu.
+func (s *Server) timestamp() string {
+	return s.Now().UTC().Format(timeFormat)
+}
+
+type listResponse struct {
+	Data       interface{} `json:"data"`
+	NextCursor *string     `json:"nextCursor"`
+}
+
+type cursor struct {
+	Offset int `json:"offset"`
+	Limit  int `json:"limit"`
+}
+
+// page returns the slice bounds and next cursor for a list request over n items.
+// The caller must hold s.mu.
+func (s *Server) page(r *http.Request, n int) (int, int, *string, error) {
+	limit := DefaultPageSize
+	if s.PageSize > 0 {
+		limit = s.PageSize
+	}
+
+	if raw := r.URL.Query().Get("limit"); raw != "" {
+		parsed, err := strconv.Atoi(raw)
+		if err != nil || parsed < 1 || parsed > MaxPageSize {
+			return 0, 0, nil, fmt.Errorf("request/query/limit must be <= %d", MaxPageSize)
+		}
+		limit = parsed
+	}
+
+	offset := 0
+	if raw := r.URL.Query().Get("cursor"); raw != "" {
+		decoded, err := base64.StdEncoding.DecodeString(raw)
+		var c cursor
+		if err == nil {
+			err = json.Unmarshal(decoded, &c)
+		}
+		if err != nil || c.Offset < 0 || c.Limit < 1 {
+			return 0, 0, nil, fmt.Errorf("An invalid cursor was provided")
+		}
+		offset, limit = c.Offset, c.Limit
+	}
+
+	if offset > n {
+		offset = n
+	}
+	end := offset + limit
+	if end >= n {
+		return offset, n, nil, nil
+	}
+
+	data, _ := json.Marshal(cursor{Offset: end, Limit: limit})
+	next := base64.StdEncoding.EncodeToString(data)
+	return offset, end, &next, nil
+}
+
+func writeJSON(w http.ResponseWriter, status int, v interface{}) {
+	w.Header().Set("Content-Type", "application/json; charset=utf-8")
+	w.WriteHeader(status)
+	_ = json.NewEncoder(w).Encode(v)
+}
+
+func writeError(w http.ResponseWriter, status int, message string) {
+	writeJSON(w, status, map[string]string{"message": message})
+}
+
+func writeNotFound(w http.ResponseWriter) {
+	writeError(w, http.StatusNotFound, "Not Found")
+}
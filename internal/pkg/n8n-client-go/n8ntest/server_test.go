@@ -0,0 +1,279 @@
+// Copyright (c) Arthur Diniz <arthurbdiniz@gmail.com>
+// SPDX-License-Identifier: Apache-2.0
+
+package n8ntest
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const testAPIKey = "test-api-key"
+
+// do sends a request to the fake server and decodes the JSON response into out when set.
+func do(t *testing.T, s *Server, method, path, body string, out interface{}) int {
+	t.Helper()
+
+	req, err := http.NewRequest(method, s.URL+path, strings.NewReader(body))
+	require.NoError(t, err)
+	req.Header.Set("X-N8N-API-KEY", s.APIKey)
+
+	res, err := s.Client().Do(req)
+	require.NoError(t, err)
+	defer res.Body.Close()
+
+	data, err := io.ReadAll(res.Body)
+	require.NoError(t, err)
+	if out != nil {
+		require.NoError(t, json.Unmarshal(data, out), string(data))
+	}
+	return res.StatusCode
+}
+
+func TestServer_Authentication(t *testing.T) {
+	s := NewServer(t, testAPIKey)
+
+	res, err := http.Get(s.URL + "/api/v1/workflows")
+	require.NoError(t, err)
+	res.Body.Close()
+	assert.Equal(t, http.StatusUnauthorized, res.StatusCode)
+
+	s.APIKey = "other-key"
+	req, err := http.NewRequest(http.MethodGet, s.URL+"/api/v1/workflows", nil)
+	require.NoError(t, err)
+	req.Header.Set("X-N8N-API-KEY", testAPIKey)
+	res, err = http.DefaultClient.Do(req)
+	require.NoError(t, err)
+	res.Body.Close()
+	assert.Equal(t, http.StatusUnauthorized, res.StatusCode)
+}
+
+func TestServer_WorkflowLifecycle(t *testing.T) {
+	s := NewServer(t, testAPIKey)
+
+	var created Workflow
+	status := do(t, s, http.MethodPost, "/api/v1/workflows", `{
+		"name": "Example",
+		"nodes": [{"name": "Start", "type": "n8n-nodes-base.manualTrigger", "typeVersion": 1, "position": [0, 0], "parameters": {}, "credentials": {"api": {"id": "1"}}}],
+		"connections": {},
+		"settings": {"executionOrder": "v1"}
+	}`, &created)
+	require.Equal(t, http.StatusOK, status)
+	assert.NotEmpty(t, created.ID)
+	assert.NotEmpty(t, created.VersionID)
+	assert.False(t, created.Active)
+	assert.Contains(t, string(created.Nodes), `"credentials"`)
+
+	var fetched Workflow
+	require.Equal(t, http.StatusOK, do(t, s, http.MethodGet, "/api/v1/workflows/"+created.ID, "", &fetched))
+	assert.Equal(t, created, fetched)
+
+	var updated Workflow
+	require.Equal(t, http.StatusOK, do(t, s, http.MethodPut, "/api/v1/workflows/"+created.ID, `{
+		"name": "Renamed", "nodes": [], "connections": {}, "settings": {}
+	}`, &updated))
+	assert.Equal(t, "Renamed", updated.Name)
+	assert.NotEqual(t, created.VersionID, updated.VersionID)
+
+	require.Equal(t, http.StatusOK, do(t, s, http.MethodDelete, "/api/v1/workflows/"+created.ID, "", nil))
+	assert.Equal(t, http.StatusNotFound, do(t, s, http.MethodGet, "/api/v1/workflows/"+created.ID, "", nil))
+	assert.Equal(t, http.StatusNotFound, do(t, s, http.MethodDelete, "/api/v1/workflows/"+created.ID, "", nil))
+}
+
+func TestServer_WorkflowValidation(t *testing.T) {
+	s := NewServer(t, testAPIKey)
+
+	tests := map[string]struct {
+		body    string
+		message string
+	}{
+		"read-only field": {
+			body:    `{"name": "x", "nodes": [], "connections": {}, "settings": {}, "active": true}`,
+			message: "request/body/active is read-only",
+		},
+		"missing settings": {
+			body:    `{"name": "x", "nodes": [], "connections": {}}`,
+			message: "request/body must have required property 'settings'",
+		},
+		"additional property": {
+			body:    `{"name": "x", "nodes": [], "connections": {}, "settings": {}, "foo": 1}`,
+			message: "request/body must NOT have additional properties",
+		},
+		"node without type": {
+			body:    `{"name": "x", "nodes": [{"name": "Start"}], "connections": {}, "settings": {}}`,
+			message: "request/body/nodes/0 must have required property 'type'",
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			var body map[string]string
+			status := do(t, s, http.MethodPost, "/api/v1/workflows", tc.body, &body)
+			assert.Equal(t, http.StatusBadRequest, status)
+			assert.Equal(t, tc.message, body["message"])
+		})
+	}
+
+	assert.Empty(t, s.Workflows())
+}
+
+func TestServer_Activation(t *testing.T) {
+	s := NewServer(t, testAPIKey)
+
+	manual := s.AddWorkflow(Workflow{
+		Name:  "Manual",
+		Nodes: json.RawMessage(`[{"name": "Start", "type": "n8n-nodes-base.manualTrigger"}]`),
+	})
+	scheduled := s.AddWorkflow(Workflow{
+		Name:  "Scheduled",
+		Nodes: json.RawMessage(`[{"name": "Every Hour", "type": "n8n-nodes-base.scheduleTrigger"}]`),
+	})
+
+	var body map[string]string
+	assert.Equal(t, http.StatusBadRequest, do(t, s, http.MethodPost, "/api/v1/workflows/"+manual.ID+"/activate", "", &body))
+	assert.Equal(t, ErrNoTriggerNode.Error(), body["message"])
+
+	var activated Workflow
+	require.Equal(t, http.StatusOK, do(t, s, http.MethodPost, "/api/v1/workflows/"+scheduled.ID+"/activate", "", &activated))
+	assert.True(t, activated.Active)
+
+	var deactivated Workflow
+	require.Equal(t, http.StatusOK, do(t, s, http.MethodPost, "/api/v1/workflows/"+scheduled.ID+"/deactivate", "", &deactivated))
+	assert.False(t, deactivated.Active)
+
+	s.CanActivate = nil
+	assert.Equal(t, http.StatusOK, do(t, s, http.MethodPost, "/api/v1/workflows/"+manual.ID+"/activate", "", nil))
+}
+
+func TestServer_Pagination(t *testing.T) {
+	s := NewServer(t, testAPIKey)
+	s.PageSize = 2
+
+	for _, name := range []string{"a", "b", "c", "d", "e"} {
+		s.AddWorkflow(Workflow{Name: name})
+	}
+
+	var names []string
+	path := "/api/v1/workflows"
+	for pages := 0; ; pages++ {
+		require.Less(t, pages, 5, "pagination did not terminate")
+
+		var res struct {
+			Data       []Workflow `json:"data"`
+			NextCursor *string    `json:"nextCursor"`
+		}
+		require.Equal(t, http.StatusOK, do(t, s, http.MethodGet, path, "", &res))
+		for _, workflow := range res.Data {
+			names = append(names, workflow.Name)
+		}
+		if res.NextCursor == nil {
+			break
+		}
+		path = "/api/v1/workflows?cursor=" + *res.NextCursor
+	}
+	assert.Equal(t, []string{"a", "b", "c", "d", "e"}, names)
+
+	assert.Equal(t, http.StatusBadRequest, do(t, s, http.MethodGet, "/api/v1/workflows?cursor=invalid", "", nil))
+	assert.Equal(t, http.StatusBadRequest, do(t, s, http.MethodGet, "/api/v1/workflows?limit=1000", "", nil))
+}
+
+func TestServer_ListWorkflowFilters(t *testing.T) {
+	s := NewServer(t, testAPIKey)
+
+	prod := s.AddTag(Tag{Name: "prod"})
+	s.AddWorkflow(Workflow{Name: "Tagged", Tags: []Tag{{ID: prod.ID}}, PinData: json.RawMessage(`{"Start": []}`)})
+	s.AddWorkflow(Workflow{Name: "Active", Active: true})
+
+	list := func(query string) []Workflow {
+		var res struct {
+			Data []Workflow `json:"data"`
+		}
+		require.Equal(t, http.StatusOK, do(t, s, http.MethodGet, "/api/v1/workflows"+query, "", &res))
+		return res.Data
+	}
+
+	assert.Len(t, list(""), 2)
+	assert.Equal(t, "Active", list("?active=true")[0].Name)
+	assert.Equal(t, "Tagged", list("?name=Tagged")[0].Name)
+
+	tagged := list("?tags=prod")
+	require.Len(t, tagged, 1)
+	assert.Equal(t, "prod", tagged[0].Tags[0].Name)
+	assert.JSONEq(t, `{"Start": []}`, string(tagged[0].PinData))
+	assert.Equal(t, "null", string(list("?tags=prod&excludePinnedData=true")[0].PinData))
+}
+
+func TestServer_Tags(t *testing.T) {
+	s := NewServer(t, testAPIKey)
+
+	var tag Tag
+	require.Equal(t, http.StatusCreated, do(t, s, http.MethodPost, "/api/v1/tags", `{"name": "prod"}`, &tag))
+	assert.Equal(t, http.StatusConflict, do(t, s, http.MethodPost, "/api/v1/tags", `{"name": "prod"}`, nil))
+
+	workflow := s.AddWorkflow(Workflow{Name: "Example"})
+
+	var tags []Tag
+	require.Equal(t, http.StatusOK, do(t, s, http.MethodPut, "/api/v1/workflows/"+workflow.ID+"/tags", `[{"id": "`+tag.ID+`"}]`, &tags))
+	require.Len(t, tags, 1)
+	assert.Equal(t, "prod", tags[0].Name)
+	assert.Equal(t, http.StatusNotFound, do(t, s, http.MethodPut, "/api/v1/workflows/"+workflow.ID+"/tags", `[{"id": "missing"}]`, nil))
+
+	require.Equal(t, http.StatusOK, do(t, s, http.MethodPut, "/api/v1/tags/"+tag.ID, `{"name": "production"}`, nil))
+	require.Equal(t, http.StatusOK, do(t, s, http.MethodGet, "/api/v1/workflows/"+workflow.ID+"/tags", "", &tags))
+	assert.Equal(t, "production", tags[0].Name)
+
+	require.Equal(t, http.StatusOK, do(t, s, http.MethodDelete, "/api/v1/tags/"+tag.ID, "", nil))
+	stored, ok := s.Workflow(workflow.ID)
+	require.True(t, ok)
+	assert.Empty(t, stored.Tags)
+}
+
+func TestServer_Executions(t *testing.T) {
+	s := NewServer(t, testAPIKey)
+
+	workflow := s.AddWorkflow(Workflow{Name: "Example"})
+	first := s.AddExecution(Execution{WorkflowID: workflow.ID, Status: "success", Data: json.RawMessage(`{"ok": true}`)})
+	s.AddExecution(Execution{WorkflowID: workflow.ID, Status: "error"})
+	s.AddExecution(Execution{WorkflowID: "other", Status: "success"})
+
+	var res struct {
+		Data []Execution `json:"data"`
+	}
+	require.Equal(t, http.StatusOK, do(t, s, http.MethodGet, "/api/v1/executions?workflowId="+workflow.ID, "", &res))
+	require.Len(t, res.Data, 2)
+	assert.Equal(t, "error", res.Data[0].Status, "most recent execution comes first")
+
+	var execution Execution
+	path := "/api/v1/executions/" + strconv.Itoa(first.ID)
+	require.Equal(t, http.StatusOK, do(t, s, http.MethodGet, path, "", &execution))
+	assert.Nil(t, execution.Data)
+	require.Equal(t, http.StatusOK, do(t, s, http.MethodGet, path+"?includeData=true", "", &execution))
+	assert.JSONEq(t, `{"ok": true}`, string(execution.Data))
+
+	require.Equal(t, http.StatusOK, do(t, s, http.MethodDelete, "/api/v1/workflows/"+workflow.ID, "", nil))
+	assert.Len(t, s.Executions(), 1, "deleting a workflow deletes its executions")
+}
+
+func TestServer_Credentials(t *testing.T) {
+	s := NewServer(t, testAPIKey)
+
+	var credential map[string]interface{}
+	require.Equal(t, http.StatusOK, do(t, s, http.MethodPost, "/api/v1/credentials", `{"name": "API", "type": "httpHeaderAuth", "data": {"value": "secret"}}`, &credential))
+	assert.Equal(t, "API", credential["name"])
+	assert.NotContains(t, credential, "data")
+
+	stored := s.Credentials()
+	require.Len(t, stored, 1)
+	assert.Equal(t, "secret", stored[0].Data["value"])
+
+	assert.Equal(t, http.StatusBadRequest, do(t, s, http.MethodPost, "/api/v1/credentials", `{"name": "API", "type": "httpHeaderAuth"}`, nil))
+	require.Equal(t, http.StatusOK, do(t, s, http.MethodDelete, "/api/v1/credentials/"+stored[0].ID, "", nil))
+	assert.Empty(t, s.Credentials())
+}
@@ -0,0 +1,191 @@
+// Copyright (c) Arthur Diniz <arthurbdiniz@gmail.com>
+// SPDX-License-Identifier: Apache-2.0
+
+package n8ntest
+
+import (
+	"encoding/json"
+	"net/http"
+)
+
+// Tag is a tag as stored and returned by the fake server.
+type Tag struct {
+	ID        string `json:"id"`
+	Name      string `json:"name"`
+	CreatedAt string `json:"createdAt"`
+	UpdatedAt string `json:"updatedAt"`
+}
+
+// AddTag stores a tag directly and returns it with its ID and timestamps
+// filled in when empty.
+func (s *Server) AddTag(tag Tag) Tag {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if tag.ID == "" {
+		tag.ID = s.newID()
+	}
+	if tag.CreatedAt == "" {
+		tag.CreatedAt = s.timestamp()
+	}
+	if tag.UpdatedAt == "" {
+		tag.UpdatedAt = tag.CreatedAt
+	}
+
+	s.tags = append(s.tags, &tag)
+	return tag
+}
+
+// Tags returns every stored tag in creation order.
+func (s *Server) Tags() []Tag {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	tags := make([]Tag, 0, len(s.tags))
+	for _, tag := range s.tags {
+		tags = append(tags, *tag)
+	}
+	return tags
+}
+
+func (s *Server) registerTagRoutes(mux *http.ServeMux) {
+	mux.HandleFunc("GET /api/v1/tags", s.listTags)
+	mux.HandleFunc("POST /api/v1/tags", s.createTag)
+	mux.HandleFunc("GET /api/v1/tags/{id}", s.getTag)
+	mux.HandleFunc("PUT /api/v1/tags/{id}", s.updateTag)
+	mux.HandleFunc("DELETE /api/v1/tags/{id}", s.deleteTag)
+}
+
+func (s *Server) listTags(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	start, end, next, err := s.page(r, len(s.tags))
+	if err != nil {
+		writeError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	data := make([]Tag, 0, end-start)
+	for _, tag := range s.tags[start:end] {
+		data = append(data, *tag)
+	}
+	writeJSON(w, http.StatusOK, listResponse{Data: data, NextCursor: next})
+}
+
+func (s *Server) createTag(w http.ResponseWriter, r *http.Request) {
+	name, ok := decodeTagBody(w, r)
+	if !ok {
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.findTagByName(name) != nil {
+		writeError(w, http.StatusConflict, "Tag already exists")
+		return
+	}
+
+	now := s.timestamp()
+	tag := &Tag{ID: s.newID(), Name: name, CreatedAt: now, UpdatedAt: now}
+	s.tags = append(s.tags, tag)
+
+	writeJSON(w, http.StatusCreated, tag)
+}
+
+func (s *Server) getTag(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	tag := s.findTag(r.PathValue("id"))
+	if tag == nil {
+		writeNotFound(w)
+		return
+	}
+
+	writeJSON(w, http.StatusOK, tag)
+}
+
+func (s *Server) updateTag(w http.ResponseWriter, r *http.Request) {
+	name, ok := decodeTagBody(w, r)
+	if !ok {
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	tag := s.findTag(r.PathValue("id"))
+	if tag == nil {
+		writeNotFound(w)
+		return
+	}
+
+	if existing := s.findTagByName(name); existing != nil && existing.ID != tag.ID {
+		writeError(w, http.StatusConflict, "Tag already exists")
+		return
+	}
+
+	tag.Name = name
+	tag.UpdatedAt = s.timestamp()
+	writeJSON(w, http.StatusOK, tag)
+}
+
+func (s *Server) deleteTag(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	id := r.PathValue("id")
+	tag := s.findTag(id)
+	if tag == nil {
+		writeNotFound(w)
+		return
+	}
+
+	s.tags = removeFirst(s.tags, func(candidate *Tag) bool { return candidate.ID == id })
+	for _, workflow := range s.workflows {
+		workflow.Tags = removeFirst(workflow.Tags, func(candidate Tag) bool { return candidate.ID == id })
+	}
+
+	writeJSON(w, http.StatusOK, tag)
+}
+
+// findTag returns the stored tag with the given ID, or nil.
+// The caller must hold s.mu.
+func (s *Server) findTag(id string) *Tag {
+	for _, tag := range s.tags {
+		if tag.ID == id {
+			return tag
+		}
+	}
+	return nil
+}
+
+// findTagByName returns the stored tag with the given name, or nil.
+// The caller must hold s.mu.
+func (s *Server) findTagByName(name string) *Tag {
+	for _, tag := range s.tags {
+		if tag.Name == name {
+			return tag
+		}
+	}
+	return nil
+}
+
+// decodeTagBody reads the name of a create or update tag request, writing
+// the error response itself when the body is invalid.
+func decodeTagBody(w http.ResponseWriter, r *http.Request) (string, bool) {
+	var body struct {
+		Name *string `json:"name"`
+	}
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		writeError(w, http.StatusBadRequest, "request/body must be object")
+		return "", false
+	}
+	if body.Name == nil {
+		writeError(w, http.StatusBadRequest, "request/body must have required property 'name'")
+		return "", false
+	}
+	return *body.Name, true
+}
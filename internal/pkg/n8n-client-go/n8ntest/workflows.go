@@ -0,0 +1,512 @@
+// Copyright (c) Arthur Diniz <arthurbdiniz@gmail.com>
+// SPDX-License-Identifier: Apache-2.0
+
+package n8ntest
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+)
+
+// Workflow is a workflow as stored and returned by the fake server.
+// Document fields are kept as raw JSON so the fake never loses data the
+// client sent, whatever shape it has.
+type Workflow struct {
+	ID           string          `json:"id"`
+	Name         string          `json:"name"`
+	Active       bool            `json:"active"`
+	VersionID    string          `json:"versionId"`
+	TriggerCount int             `json:"triggerCount"`
+	CreatedAt    string          `json:"createdAt"`
+	UpdatedAt    string          `json:"updatedAt"`
+	Nodes        json.RawMessage `json:"nodes"`
+	Connections  json.RawMessage `json:"connections"`
+	Settings     json.RawMessage `json:"settings"`
+	StaticData   json.RawMessage `json:"staticData"`
+	PinData      json.RawMessage `json:"pinData"`
+	Meta         json.RawMessage `json:"meta"`
+
+	// Tags holds the workflow tags. Only the IDs are significant when
+	// seeding; responses always carry the current tag details.
+	Tags []Tag `json:"tags"`
+}
+
+// ErrNoTriggerNode is returned by RequireTriggerNode, with the message n8n uses.
+var ErrNoTriggerNode = errors.New("Workflow has no node to start the workflow - at least one trigger, poller or webhook node is required")
+
+// nonActivatingTriggers are trigger nodes that only start a workflow by hand,
+// as a sub-workflow or on the error of another workflow, and therefore do not
+// allow it to be activated.
+var nonActivatingTriggers = map[string]bool{
+	"n8n-nodes-base.manualTrigger":               true,
+	"n8n-nodes-base.start":                       true,
+	"n8n-nodes-base.executeWorkflowTrigger":      true,
+	"n8n-nodes-base.errorTrigger":                true,
+	"@n8n/n8n-nodes-langchain.manualChatTrigger": true,
+}
+
+// activatingNodes are the poller and webhook nodes allowing a workflow to be
+// activated whose type does not end with Trigger.
+var activatingNodes = map[string]bool{
+	"n8n-nodes-base.webhook":       true,
+	"n8n-nodes-base.cron":          true,
+	"n8n-nodes-base.interval":      true,
+	"n8n-nodes-base.emailReadImap": true,
+}
+
+// RequireTriggerNode is the default activation rule. Like n8n, it only allows
+// workflows with at least one enabled trigger, poller or webhook node to be
+// activated; manual, Execute Workflow and Error triggers do not count.
+func RequireTriggerNode(workflow Workflow) error {
+	var nodes []struct {
+		Type     string `json:"type"`
+		Disabled bool   `json:"disabled"`
+	}
+	if err := json.Unmarshal(workflow.Nodes, &nodes); err != nil {
+		return ErrNoTriggerNode
+	}
+
+	for _, node := range nodes {
+		if node.Disabled || nonActivatingTriggers[node.Type] {
+			continue
+		}
+		if activatingNodes[node.Type] || strings.HasSuffix(node.Type, "Trigger") {
+			return nil
+		}
+	}
+
+	return ErrNoTriggerNode
+}
+
+// AddWorkflow stores a workflow directly, bypassing request validation, and
+// returns it with its ID, version and timestamps filled in when empty.
+func (s *Server) AddWorkflow(workflow Workflow) Workflow {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if workflow.ID == "" {
+		workflow.ID = s.newID()
+	}
+	if workflow.VersionID == "" {
+		workflow.VersionID = s.newVersionID()
+	}
+	if workflow.CreatedAt == "" {
+		workflow.CreatedAt = s.timestamp()
+	}
+	if workflow.UpdatedAt == "" {
+		workflow.UpdatedAt = workflow.CreatedAt
+	}
+	if workflow.Nodes == nil {
+		workflow.Nodes = json.RawMessage(`[]`)
+	}
+	if workflow.Connections == nil {
+		workflow.Connections = json.RawMessage(`{}`)
+	}
+	if workflow.Settings == nil {
+		workflow.Settings = json.RawMessage(`{}`)
+	}
+
+	s.workflows = append(s.workflows, &workflow)
+	return s.renderWorkflow(&workflow)
+}
+
+// Workflow returns the stored workflow with the given ID.
+func (s *Server) Workflow(id string) (Workflow, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	workflow := s.findWorkflow(id)
+	if workflow == nil {
+		return Workflow{}, false
+	}
+	return s.renderWorkflow(workflow), true
+}
+
+// Workflows returns every stored workflow in creation order.
+func (s *Server) Workflows() []Workflow {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	workflows := make([]Workflow, 0, len(s.workflows))
+	for _, workflow := range s.workflows {
+		workflows = append(workflows, s.renderWorkflow(workflow))
+	}
+	return workflows
+}
+
+func (s *Server) registerWorkflowRoutes(mux *http.ServeMux) {
+	mux.HandleFunc("GET /api/v1/workflows", s.listWorkflows)
+	mux.HandleFunc("POST /api/v1/workflows", s.createWorkflow)
+	mux.HandleFunc("GET /api/v1/workflows/{id}", s.getWorkflow)
+	mux.HandleFunc("PUT /api/v1/workflows/{id}", s.updateWorkflow)
+	mux.HandleFunc("DELETE /api/v1/workflows/{id}", s.deleteWorkflow)
+	mux.HandleFunc("POST /api/v1/workflows/{id}/activate", s.activateWorkflow)
+	mux.HandleFunc("POST /api/v1/workflows/{id}/deactivate", s.deactivateWorkflow)
+	mux.HandleFunc("GET /api/v1/workflows/{id}/tags", s.getWorkflowTags)
+	mux.HandleFunc("PUT /api/v1/workflows/{id}/tags", s.updateWorkflowTags)
+}
+
+func (s *Server) listWorkflows(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	query := r.URL.Query()
+
+	var tagNames []string
+	if raw := query.Get("tags"); raw != "" {
+		tagNames = strings.Split(raw, ",")
+	}
+
+	var matches []Workflow
+	for _, stored := range s.workflows {
+		workflow := s.renderWorkflow(stored)
+		if name := query.Get("name"); name != "" && workflow.Name != name {
+			continue
+		}
+		if active := query.Get("active"); active != "" && fmt.Sprint(workflow.Active) != active {
+			continue
+		}
+		if len(tagNames) > 0 && !hasAnyTag(workflow, tagNames) {
+			continue
+		}
+		if query.Get("excludePinnedData") == "true" {
+			workflow.PinData = nil
+		}
+		matches = append(matches, workflow)
+	}
+
+	start, end, next, err := s.page(r, len(matches))
+	if err != nil {
+		writeError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	data := make([]Workflow, 0, end-start)
+	data = append(data, matches[start:end]...)
+	writeJSON(w, http.StatusOK, listResponse{Data: data, NextCursor: next})
+}
+
+func (s *Server) createWorkflow(w http.ResponseWriter, r *http.Request) {
+	body, err := decodeWorkflowBody(r)
+	if err != nil {
+		writeError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	now := s.timestamp()
+	workflow := &Workflow{
+		ID:        s.newID(),
+		VersionID: s.newVersionID(),
+		CreatedAt: now,
+		UpdatedAt: now,
+	}
+	body.applyTo(workflow)
+
+	s.workflows = append(s.workflows, workflow)
+	writeJSON(w, http.StatusOK, s.renderWorkflow(workflow))
+}
+
+func (s *Server) getWorkflow(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	workflow := s.findWorkflow(r.PathValue("id"))
+	if workflow == nil {
+		writeNotFound(w)
+		return
+	}
+
+	rendered := s.renderWorkflow(workflow)
+	if r.URL.Query().Get("excludePinnedData") == "true" {
+		rendered.PinData = nil
+	}
+	writeJSON(w, http.StatusOK, rendered)
+}
+
+func (s *Server) updateWorkflow(w http.ResponseWriter, r *http.Request) {
+	body, err := decodeWorkflowBody(r)
+	if err != nil {
+		writeError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	workflow := s.findWorkflow(r.PathValue("id"))
+	if workflow == nil {
+		writeNotFound(w)
+		return
+	}
+
+	body.applyTo(workflow)
+	workflow.VersionID = s.newVersionID()
+	workflow.UpdatedAt = s.timestamp()
+
+	writeJSON(w, http.StatusOK, s.renderWorkflow(workflow))
+}
+
+func (s *Server) deleteWorkflow(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	id := r.PathValue("id")
+	workflow := s.findWorkflow(id)
+	if workflow == nil {
+		writeNotFound(w)
+		return
+	}
+
+	rendered := s.renderWorkflow(workflow)
+
+	s.workflows = removeFirst(s.workflows, func(candidate *Workflow) bool { return candidate.ID == id })
+	var executions []*Execution
+	for _, execution := range s.executions {
+		if execution.WorkflowID != id {
+			executions = append(executions, execution)
+		}
+	}
+	s.executions = executions
+
+	writeJSON(w, http.StatusOK, rendered)
+}
+
+func (s *Server) activateWorkflow(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	workflow := s.findWorkflow(r.PathValue("id"))
+	if workflow == nil {
+		writeNotFound(w)
+		return
+	}
+
+	if s.CanActivate != nil {
+		if err := s.CanActivate(s.renderWorkflow(workflow)); err != nil {
+			writeError(w, http.StatusBadRequest, err.Error())
+			return
+		}
+	}
+
+	workflow.Active = true
+	writeJSON(w, http.StatusOK, s.renderWorkflow(workflow))
+}
+
+func (s *Server) deactivateWorkflow(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	workflow := s.findWorkflow(r.PathValue("id"))
+	if workflow == nil {
+		writeNotFound(w)
+		return
+	}
+
+	workflow.Active = false
+	writeJSON(w, http.StatusOK, s.renderWorkflow(workflow))
+}
+
+func (s *Server) getWorkflowTags(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	workflow := s.findWorkflow(r.PathValue("id"))
+	if workflow == nil {
+		writeNotFound(w)
+		return
+	}
+
+	writeJSON(w, http.StatusOK, s.renderWorkflow(workflow).Tags)
+}
+
+func (s *Server) updateWorkflowTags(w http.ResponseWriter, r *http.Request) {
+	var body []struct {
+		ID string `json:"id"`
+	}
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		writeError(w, http.StatusBadRequest, "request/body must be array")
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	workflow := s.findWorkflow(r.PathValue("id"))
+	if workflow == nil {
+		writeNotFound(w)
+		return
+	}
+
+	tags := make([]Tag, 0, len(body))
+	for _, ref := range body {
+		if s.findTag(ref.ID) == nil {
+			writeError(w, http.StatusNotFound, "Some tags not found")
+			return
+		}
+		tags = append(tags, Tag{ID: ref.ID})
+	}
+
+	workflow.Tags = tags
+	workflow.UpdatedAt = s.timestamp()
+	writeJSON(w, http.StatusOK, s.renderWorkflow(workflow).Tags)
+}
+
+// findWorkflow returns the stored workflow with the given ID, or nil.
+// The caller must hold s.mu.
+func (s *Server) findWorkflow(id string) *Workflow {
+	for _, workflow := range s.workflows {
+		if workflow.ID == id {
+			return workflow
+		}
+	}
+	return nil
+}
+
+// renderWorkflow returns a copy of the workflow as the API would return it,
+// with the current details of its tags. The caller must hold s.mu.
+func (s *Server) renderWorkflow(workflow *Workflow) Workflow {
+	rendered := *workflow
+	rendered.Tags = make([]Tag, 0, len(workflow.Tags))
+	for _, ref := range workflow.Tags {
+		if tag := s.findTag(ref.ID); tag != nil {
+			rendered.Tags = append(rendered.Tags, *tag)
+		}
+	}
+	return rendered
+}
+
+// newVersionID returns a unique UUID-shaped version identifier.
+// The caller must hold s.mu.
+func (s *Server) newVersionID() string {
+	s.nextID++
+	return fmt.Sprintf("00000000-0000-4000-8000-%012d", s.nextID)
+}
+
+func hasAnyTag(workflow Workflow, names []string) bool {
+	for _, tag := range workflow.Tags {
+		for _, name := range names {
+			if tag.Name == name {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// workflowBody holds the fields of a validated create or update request.
+type workflowBody struct {
+	name        string
+	nodes       json.RawMessage
+	connections json.RawMessage
+	settings    json.RawMessage
+	staticData  json.RawMessage
+	pinData     json.RawMessage
+}
+
+func (b workflowBody) applyTo(workflow *Workflow) {
+	workflow.Name = b.name
+	workflow.Nodes = b.nodes
+	workflow.Connections = b.connections
+	workflow.Settings = b.settings
+	workflow.StaticData = b.staticData
+	workflow.PinData = b.pinData
+}
+
+var (
+	requiredWorkflowFields = []string{"name", "nodes", "connections", "settings"}
+	readOnlyWorkflowFields = map[string]bool{"id": true, "active": true, "createdAt": true, "updatedAt": true, "tags": true}
+	optionalWorkflowFields = map[string]bool{"staticData": true, "pinData": true}
+)
+
+// decodeWorkflowBody validates a create or update request body against the
+// rules of the n8n workflow schema and reports violations with n8n's messages.
+func decodeWorkflowBody(r *http.Request) (workflowBody, error) {
+	var fields map[string]json.RawMessage
+	if err := json.NewDecoder(r.Body).Decode(&fields); err != nil || fields == nil {
+		return workflowBody{}, errors.New("request/body must be object")
+	}
+
+	for key := range fields {
+		if readOnlyWorkflowFields[key] {
+			return workflowBody{}, fmt.Errorf("request/body/%s is read-only", key)
+		}
+	}
+
+	for _, key := range requiredWorkflowFields {
+		if _, ok := fields[key]; !ok {
+			return workflowBody{}, fmt.Errorf("request/body must have required property '%s'", key)
+		}
+	}
+
+	for key := range fields {
+		if !optionalWorkflowFields[key] && !contains(requiredWorkflowFields, key) {
+			return workflowBody{}, errors.New("request/body must NOT have additional properties")
+		}
+	}
+
+	body := workflowBody{
+		nodes:       fields["nodes"],
+		connections: fields["connections"],
+		settings:    fields["settings"],
+		staticData:  nullToNil(fields["staticData"]),
+		pinData:     nullToNil(fields["pinData"]),
+	}
+
+	if err := json.Unmarshal(fields["name"], &body.name); err != nil {
+		return workflowBody{}, errors.New("request/body/name must be string")
+	}
+
+	var nodes []map[string]json.RawMessage
+	if err := json.Unmarshal(body.nodes, &nodes); err != nil || nodes == nil {
+		return workflowBody{}, errors.New("request/body/nodes must be array")
+	}
+	for i, node := range nodes {
+		for _, key := range []string{"name", "type"} {
+			if _, ok := node[key]; !ok {
+				return workflowBody{}, fmt.Errorf("request/body/nodes/%d must have required property '%s'", i, key)
+			}
+		}
+	}
+
+	var object map[string]json.RawMessage
+	if err := json.Unmarshal(body.connections, &object); err != nil || object == nil {
+		return workflowBody{}, errors.New("request/body/connections must be object")
+	}
+	object = nil
+	if err := json.Unmarshal(body.settings, &object); err != nil || object == nil {
+		return workflowBody{}, errors.New("request/body/settings must be object")
+	}
+
+	return body, nil
+}
+
+func nullToNil(raw json.RawMessage) json.RawMessage {
+	if string(raw) == "null" {
+		return nil
+	}
+	return raw
+}
+
+func contains(values []string, value string) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
+
+func removeFirst[T any](items []T, match func(T) bool) []T {
+	for i, item := range items {
+		if match(item) {
+			return append(items[:i:i], items[i+1:]...)
+		}
+	}
+	return items
+}
@@ -0,0 +1,69 @@
+// Copyright (c) Arthur Diniz <arthurbdiniz@gmail.com>
+// SPDX-License-Identifier: Apache-2.0
+
+package n8n
+
+import (
+	"context"
+
+	"golang.org/x/time/rate"
+)
+
+// WithRateLimit limits the client to requestsPerSecond requests per second
+// using a token bucket holding up to burst requests. Every request waits for
+// a token, so a single client shared between callers limits all of them.
+// A requestsPerSecond of zero or less disables the limit.
+//
+// Example:
+//
+//	n8n.WithRateLimit(5, 1)
+func WithRateLimit(requestsPerSecond float64, burst int) ClientOption {
+	return func(c *Client) {
+		if requestsPerSecond <= 0 {
+			c.limiter = nil
+			return
+		}
+		if burst < 1 {
+			burst = 1
+		}
+		c.limiter = rate.NewLimiter(rate.Limit(requestsPerSecond), burst)
+	}
+}
+
+// WithMaxConcurrentRequests limits the number of requests the client has in
+// flight at once. Further requests wait until a slot is released.
+// A maxConcurrentRequests of zero or less disables the limit.
+//
+// Example:
+//
+//	n8n.WithMaxConcurrentRequests(2)
+func WithMaxConcurrentRequests(maxConcurrentRequests int) ClientOption {
+	return func(c *Client) {
+		if maxConcurrentRequests <= 0 {
+			c.slots = nil
+			return
+		}
+		c.slots = make(chan struct{}, maxConcurrentRequests)
+	}
+}
+
+// acquire waits for the rate limiter and a free request slot. The returned
+// function releases the slot and must be called once the request is done.
+func (c *Client) acquire(ctx context.Context) (func(), error) {
+	if c.limiter != nil {
+		if err := c.limiter.Wait(ctx); err != nil {
+			return nil, err
+		}
+	}
+
+	if c.slots == nil {
+		return func() {}, nil
+	}
+
+	select {
+	case c.slots <- struct{}{}:
+		return func() { <-c.slots }, nil
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	}
+}
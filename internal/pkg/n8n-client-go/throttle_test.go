@@ -0,0 +1,134 @@
+// Copyright (c) Arthur Diniz <arthurbdiniz@gmail.com>
+// SPDX-License-Identifier: Apache-2.0
+
+package n8n
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestDoRequest_RateLimit(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer ts.Close()
+
+	token := "test-token"
+	client, err := NewClient(&ts.URL, &token, WithRateLimit(50, 1))
+	if err != nil {
+		t.Fatalf("failed to create client: %v", err)
+	}
+
+	start := time.Now()
+	for i := 0; i < 5; i++ {
+		req, err := http.NewRequest(http.MethodGet, client.HostURL+"/test", nil)
+		if err != nil {
+			t.Fatalf("failed to create request: %v", err)
+		}
+		if _, err := client.doRequest(req); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+	}
+
+	// The first request uses the initial token; the other four wait 20ms each.
+	if elapsed := time.Since(start); elapsed < 80*time.Millisecond {
+		t.Errorf("expected 5 requests at 50 per second to take at least 80ms, took %s", elapsed)
+	}
+}
+
+func TestDoRequest_MaxConcurrentRequests(t *testing.T) {
+	var inFlight, maxInFlight int32
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		current := atomic.AddInt32(&inFlight, 1)
+		defer atomic.AddInt32(&inFlight, -1)
+		for {
+			observed := atomic.LoadInt32(&maxInFlight)
+			if current <= observed || atomic.CompareAndSwapInt32(&maxInFlight, observed, current) {
+				break
+			}
+		}
+		time.Sleep(20 * time.Millisecond)
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer ts.Close()
+
+	token := "test-token"
+	client, err := NewClient(&ts.URL, &token, WithMaxConcurrentRequests(2))
+	if err != nil {
+		t.Fatalf("failed to create client: %v", err)
+	}
+
+	var wg sync.WaitGroup
+	errs := make(chan error, 8)
+	for i := 0; i < 8; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			req, err := http.NewRequest(http.MethodGet, client.HostURL+"/test", nil)
+			if err != nil {
+				errs <- err
+				return
+			}
+			if _, err := client.doRequest(req); err != nil {
+				errs <- err
+			}
+		}()
+	}
+	wg.Wait()
+	close(errs)
+
+	for err := range errs {
+		t.Errorf("unexpected error: %v", err)
+	}
+	if maxInFlight > 2 {
+		t.Errorf("expected at most 2 requests in flight, got %d", maxInFlight)
+	}
+}
+
+func TestDoRequest_ThrottleCanceled(t *testing.T) {
+	client := newMockClient(func(req *http.Request) (*http.Response, error) {
+		t.Fatalf("expected the request not to be sent")
+		return nil, nil
+	})
+	WithMaxConcurrentRequests(1)(client)
+
+	// Occupy the only slot so the next request has to wait.
+	release, err := client.acquire(context.Background())
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	defer release()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, client.HostURL+"/test", nil)
+	if err != nil {
+		t.Fatalf("failed to create request: %v", err)
+	}
+
+	if _, err := client.doRequest(req); !errors.Is(err, context.Canceled) {
+		t.Errorf("expected context.Canceled, got %v", err)
+	}
+}
+
+func TestWithRateLimit_Disabled(t *testing.T) {
+	client := &Client{}
+
+	WithRateLimit(0, 1)(client)
+	WithMaxConcurrentRequests(0)(client)
+
+	if client.limiter != nil {
+		t.Errorf("expected no rate limiter")
+	}
+	if client.slots != nil {
+		t.Errorf("expected no concurrency limit")
+	}
+}
@@ -8,6 +8,8 @@ import (
 	"encoding/json"
 	"fmt"
 	"net/http"
+	"net/url"
+	"strings"
 )
 
 // GetWorkflows retrieves all workflows from your n8n instance.
@@ -17,17 +19,47 @@ import (
 // Returns a pointer to a WorkflowsResponse containing all workflows,
 // or an error if the request or response decoding fails.
 func (c *Client) GetWorkflows() (*WorkflowsResponse, error) {
+	return c.listWorkflows(url.Values{})
+}
+
+// GetWorkflowsByName retrieves all workflows with the given name from your n8n instance,
+// using the name filter of the workflows list endpoint.
+// Like GetWorkflows, it automatically iterates through all available pages.
+//
+// Parameters:
+//   - name: the name of the workflows to retrieve.
+//
+// Returns a pointer to a WorkflowsResponse containing the matching workflows,
+// or an error if the request or response decoding fails.
+func (c *Client) GetWorkflowsByName(name string) (*WorkflowsResponse, error) {
+	return c.listWorkflows(url.Values{"name": []string{name}})
+}
+
+// GetWorkflowsByTags retrieves all workflows having at least one of the given tags,
+// using the tags filter of the workflows list endpoint.
+// Like GetWorkflows, it automatically iterates through all available pages.
+//
+// Parameters:
+//   - tags: the names of the tags to match.
+//
+// Returns a pointer to a WorkflowsResponse containing the matching workflows,
+// or an error if the request or response decoding fails.
+func (c *Client) GetWorkflowsByTags(tags []string) (*WorkflowsResponse, error) {
+	return c.listWorkflows(url.Values{"tags": []string{strings.Join(tags, ",")}})
+}
+
+// listWorkflows retrieves every page of the workflows list endpoint for the given query.
+func (c *Client) listWorkflows(query url.Values) (*WorkflowsResponse, error) {
 	var allWorkflows WorkflowsResponse
-	cursor := ""
 
 	for {
-		url := fmt.Sprintf("%s/api/v1/workflows", c.HostURL)
-		// Only append the cursor if it's not empty
-		if cursor != "" {
-			url = fmt.Sprintf("%s?cursor=%s", url, cursor)
+		endpoint := fmt.Sprintf("%s/api/v1/workflows", c.HostURL)
+		// Only append the query if it's not empty
+		if len(query) > 0 {
+			endpoint = fmt.Sprintf("%s?%s", endpoint, query.Encode())
 		}
 
-		req, err := http.NewRequest("GET", url, nil)
+		req, err := http.NewRequestWithContext(c.context(), "GET", endpoint, nil)
 		if err != nil {
 			return nil, err
 		}
@@ -44,10 +76,10 @@ func (c *Client) GetWorkflows() (*WorkflowsResponse, error) {
 		}
 
 		allWorkflows.Data = append(allWorkflows.Data, workflows.Data...)
-		if workflows.NextCursor == nil {
+		if workflows.NextCursor == nil || *workflows.NextCursor == "" {
 			break
 		}
-		cursor = *workflows.NextCursor
+		query.Set("cursor", *workflows.NextCursor)
 	}
 
 	return &allWorkflows, nil
@@ -60,7 +92,7 @@ func (c *Client) GetWorkflows() (*WorkflowsResponse, error) {
 //
 // Returns a pointer to the Workflow struct, or an error if the request or decoding fails.
 func (c *Client) GetWorkflow(workflowID string) (*Workflow, error) {
-	req, err := http.NewRequest("GET", fmt.Sprintf("%s/api/v1/workflows/%s", c.HostURL, workflowID), nil)
+	req, err := http.NewRequestWithContext(c.context(), "GET", fmt.Sprintf("%s/api/v1/workflows/%s", c.HostURL, workflowID), nil)
 	if err != nil {
 		return nil, err
 	}
@@ -86,7 +118,7 @@ func (c *Client) GetWorkflow(workflowID string) (*Workflow, error) {
 //
 // Returns the deleted Workflow object, or an error if the request or decoding fails.
 func (c *Client) DeleteWorkflow(workflowID string) (*Workflow, error) {
-	req, err := http.NewRequest("DELETE", fmt.Sprintf("%s/api/v1/workflows/%s", c.HostURL, workflowID), nil)
+	req, err := http.NewRequestWithContext(c.context(), "DELETE", fmt.Sprintf("%s/api/v1/workflows/%s", c.HostURL, workflowID), nil)
 	if err != nil {
 		return nil, err
 	}
@@ -113,7 +145,7 @@ func (c *Client) DeleteWorkflow(workflowID string) (*Workflow, error) {
 //
 // Returns the updated Workflow object, or an error if the request or decoding fails.
 func (c *Client) DeactivateWorkflow(workflowID string) (*Workflow, error) {
-	req, err := http.NewRequest("POST", fmt.Sprintf("%s/api/v1/workflows/%s/deactivate", c.HostURL, workflowID), nil)
+	req, err := http.NewRequestWithContext(c.context(), "POST", fmt.Sprintf("%s/api/v1/workflows/%s/deactivate", c.HostURL, workflowID), nil)
 	if err != nil {
 		return nil, err
 	}
@@ -139,7 +171,7 @@ func (c *Client) DeactivateWorkflow(workflowID string) (*Workflow, error) {
 //
 // Returns the updated Workflow object, or an error if the request or decoding fails.
 func (c *Client) ActivateWorkflow(workflowID string) (*Workflow, error) {
-	req, err := http.NewRequest("POST", fmt.Sprintf("%s/api/v1/workflows/%s/activate", c.HostURL, workflowID), nil)
+	req, err := http.NewRequestWithContext(c.context(), "POST", fmt.Sprintf("%s/api/v1/workflows/%s/activate", c.HostURL, workflowID), nil)
 	if err != nil {
 		return nil, err
 	}
@@ -172,7 +204,7 @@ func (c *Client) CreateWorkflow(createWorkflowRequest *CreateWorkflowRequest) (*
 	}
 
 	// Create the HTTP POST request
-	req, err := http.NewRequest("POST", fmt.Sprintf("%s/api/v1/workflows", c.HostURL), bytes.NewReader(payload))
+	req, err := http.NewRequestWithContext(c.context(), "POST", fmt.Sprintf("%s/api/v1/workflows", c.HostURL), bytes.NewReader(payload))
 
 	if err != nil {
 		return nil, fmt.Errorf("failed to create request: %w", err)
@@ -203,14 +235,19 @@ func (c *Client) CreateWorkflow(createWorkflowRequest *CreateWorkflowRequest) (*
 //
 // Returns the updated Workflow object or an error if the request or decoding fails.
 func (c *Client) UpdateWorkflow(id string, updateWorkflowRequest *UpdateWorkflowRequest) (*Workflow, error) {
+	return c.putWorkflow(id, updateWorkflowRequest)
+}
+
+// putWorkflow sends request as the new content of the workflow with the given ID.
+func (c *Client) putWorkflow(id string, request interface{}) (*Workflow, error) {
 	// Marshal the updated workflow into JSON
-	payload, err := json.Marshal(updateWorkflowRequest)
+	payload, err := json.Marshal(request)
 	if err != nil {
 		return nil, fmt.Errorf("failed to marshal updated workflow: %w", err)
 	}
 
 	// Create the HTTP PUT request
-	req, err := http.NewRequest("PUT", fmt.Sprintf("%s/api/v1/workflows/%s", c.HostURL, id), bytes.NewReader(payload))
+	req, err := http.NewRequestWithContext(c.context(), "PUT", fmt.Sprintf("%s/api/v1/workflows/%s", c.HostURL, id), bytes.NewReader(payload))
 
 	if err != nil {
 		return nil, fmt.Errorf("failed to create request: %w", err)
@@ -231,3 +268,121 @@ func (c *Client) UpdateWorkflow(id string, updateWorkflowRequest *UpdateWorkflow
 
 	return workflow, nil
 }
+
+// UpdateWorkflowSettings changes the settings of an existing workflow while
+// leaving its name, nodes and connections untouched.
+// It retrieves the workflow, applies update to a copy of its current settings
+// and sends the workflow back with the new settings. Settings that are empty
+// both before and after the update are left out of the request, so n8n keeps
+// treating them as unset, while settings cleared by update are sent empty.
+//
+// Parameters:
+//   - id: the ID of the workflow to be updated.
+//   - update: a function changing the settings in place.
+//
+// Returns the updated Workflow object or an error if retrieving or updating the workflow fails.
+//
+// Example:
+//
+//	workflow, err := client.UpdateWorkflowSettings("1", func(settings *n8n.Settings) {
+//		settings.ErrorWorkflow = "2"
+//	})
+func (c *Client) UpdateWorkflowSettings(id string, update func(settings *Settings)) (*Workflow, error) {
+	workflow, err := c.GetWorkflow(id)
+	if err != nil {
+		return nil, err
+	}
+
+	settings := workflow.Settings
+	if workflow.Settings.Extra != nil {
+		settings.Extra = make(map[string]json.RawMessage, len(workflow.Settings.Extra))
+		for k, v := range workflow.Settings.Extra {
+			settings.Extra[k] = v
+		}
+	}
+	update(&settings)
+
+	fields, err := updatedSettingsFields(workflow.Settings, settings)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal workflow settings: %w", err)
+	}
+
+	return c.putWorkflow(id, &updateWorkflowSettingsRequest{
+		Name:        workflow.Name,
+		Nodes:       workflow.Nodes,
+		Connections: workflow.Connections,
+		Settings:    fields,
+		PinData:     nonNullJSON(workflow.PinData),
+		StaticData:  nonNullJSON(workflow.StaticData),
+	})
+}
+
+// updateWorkflowSettingsRequest is the request sent by UpdateWorkflowSettings.
+// It differs from UpdateWorkflowRequest by holding the settings as encoded
+// fields, so unset settings can be left out.
+type updateWorkflowSettingsRequest struct {
+	Name        string                     `json:"name"`
+	Nodes       []Node                     `json:"nodes"`
+	Connections map[string]Connection      `json:"connections"`
+	Settings    map[string]json.RawMessage `json:"settings"`
+	PinData     json.RawMessage            `json:"pinData,omitempty"`
+	StaticData  json.RawMessage            `json:"staticData,omitempty"`
+}
+
+// optionalSettings are the JSON keys of the settings n8n treats as unset
+// when they are empty.
+var optionalSettings = []string{
+	"saveDataErrorExecution",
+	"saveDataSuccessExecution",
+	"executionTimeout",
+	"errorWorkflow",
+	"timezone",
+	"executionOrder",
+}
+
+// updatedSettingsFields returns the encoded fields of updated, without the
+// optional settings that are empty both in current and in updated.
+func updatedSettingsFields(current, updated Settings) (map[string]json.RawMessage, error) {
+	before, err := settingsFields(current)
+	if err != nil {
+		return nil, err
+	}
+	after, err := settingsFields(updated)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, key := range optionalSettings {
+		if isEmptyJSON(before[key]) && isEmptyJSON(after[key]) {
+			delete(after, key)
+		}
+	}
+	return after, nil
+}
+
+// settingsFields returns the encoded fields of settings, by JSON key.
+func settingsFields(settings Settings) (map[string]json.RawMessage, error) {
+	data, err := json.Marshal(settings)
+	if err != nil {
+		return nil, err
+	}
+
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal(data, &fields); err != nil {
+		return nil, err
+	}
+	return fields, nil
+}
+
+// isEmptyJSON reports whether value is an empty string or zero.
+func isEmptyJSON(value json.RawMessage) bool {
+	return string(value) == `""` || string(value) == "0"
+}
+
+// nonNullJSON returns nil for a JSON null, so it is omitted from requests.
+func nonNullJSON(data json.RawMessage) json.RawMessage {
+	if string(bytes.TrimSpace(data)) == "null" {
+		return nil
+	}
+	return data
+}
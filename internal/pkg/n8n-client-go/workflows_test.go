@@ -10,6 +10,7 @@ import (
 	"path"
 	"testing"
 
+	"github.com/arthurbdiniz/terraform-provider-n8n/internal/pkg/n8n-client-go/n8ntest"
 	"github.com/stretchr/testify/require"
 )
 
@@ -257,7 +258,7 @@ func TestCreateWorkflow(t *testing.T) {
 				Name:        "Start",
 				Type:        "n8n-nodes-base.start",
 				TypeVersion: 1,
-				Position:    []int{0, 0},
+				Position:    []float64{0, 0},
 				Parameters:  map[string]interface{}{},
 			},
 		},
@@ -345,7 +346,7 @@ func TestUpdateWorkflow(t *testing.T) {
 				Name:        "Start",
 				Type:        "n8n-nodes-base.start",
 				TypeVersion: 1,
-				Position:    []int{0, 0},
+				Position:    []float64{0, 0},
 				Parameters:  map[string]interface{}{},
 			},
 			{
@@ -353,7 +354,7 @@ func TestUpdateWorkflow(t *testing.T) {
 				Name:        "Set Node",
 				Type:        "n8n-nodes-base.set",
 				TypeVersion: 1,
-				Position:    []int{300, 0},
+				Position:    []float64{300, 0},
 				Parameters: map[string]interface{}{
 					"values": map[string]interface{}{
 						"string": []map[string]interface{}{
@@ -382,3 +383,285 @@ func TestUpdateWorkflow(t *testing.T) {
 	require.Len(t, workflow.Nodes, 2)
 	require.Equal(t, "Set Node", workflow.Nodes[1].Name)
 }
+
+func TestGetWorkflowWithPinDataStaticDataAndMeta(t *testing.T) {
+	mockResponse := `{
+		"id": "3LODqkaWPmYOi0FA",
+		"name": "Test Workflow",
+		"pinData": {"Start": [{"json": {"id": 1}}]},
+		"staticData": {"node:Schedule Trigger": {"recurrenceRules": []}},
+		"meta": {"templateCredsSetupCompleted": true}
+	}`
+	handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+		if _, err := w.Write([]byte(mockResponse)); err != nil {
+			t.Errorf("failed to write response: %v", err)
+		}
+	})
+
+	ts := httptest.NewServer(handler)
+	defer ts.Close()
+
+	token := "test-token"
+	client, err := NewClient(&ts.URL, &token)
+	require.NoError(t, err)
+
+	workflow, err := client.GetWorkflow("3LODqkaWPmYOi0FA")
+	require.NoError(t, err)
+
+	require.JSONEq(t, `{"Start": [{"json": {"id": 1}}]}`, string(workflow.PinData))
+	require.JSONEq(t, `{"node:Schedule Trigger": {"recurrenceRules": []}}`, string(workflow.StaticData))
+	require.JSONEq(t, `{"templateCredsSetupCompleted": true}`, string(workflow.Meta))
+}
+
+func TestCreateWorkflowWithPinData(t *testing.T) {
+	handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var payload map[string]json.RawMessage
+		if err := json.NewDecoder(r.Body).Decode(&payload); err != nil {
+			t.Errorf("failed to decode request body: %v", err)
+		}
+		require.JSONEq(t, `{"Start": [{"json": {"id": 1}}]}`, string(payload["pinData"]))
+		require.NotContains(t, payload, "staticData")
+
+		w.WriteHeader(http.StatusOK)
+		if _, err := w.Write([]byte(`{"id": "123456", "name": "Test Workflow", "pinData": {"Start": [{"json": {"id": 1}}]}}`)); err != nil {
+			t.Errorf("failed to write response: %v", err)
+		}
+	})
+
+	ts := httptest.NewServer(handler)
+	defer ts.Close()
+
+	token := "test-token"
+	client, err := NewClient(&ts.URL, &token)
+	require.NoError(t, err)
+
+	workflow, err := client.CreateWorkflow(&CreateWorkflowRequest{
+		Name:        "Test Workflow",
+		Nodes:       []Node{},
+		Connections: map[string]Connection{},
+		PinData:     json.RawMessage(`{"Start": [{"json": {"id": 1}}]}`),
+	})
+	require.NoError(t, err)
+	require.JSONEq(t, `{"Start": [{"json": {"id": 1}}]}`, string(workflow.PinData))
+}
+
+func TestGetWorkflowsByName(t *testing.T) {
+	mockResponses := []string{
+		`{"data": [{"id": "3LODqkaWPmYOi0FA", "name": "Order Sync"}], "nextCursor": "abc"}`,
+		`{"data": [{"id": "if4hSGz1GkaYMLTq", "name": "Order Sync"}], "nextCursor": null}`,
+	}
+	requestCount := 0
+	handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		query := r.URL.Query()
+		if query.Get("name") != "Order Sync" {
+			t.Errorf("expected name filter 'Order Sync', got '%s'", query.Get("name"))
+		}
+		if requestCount == 1 && query.Get("cursor") != "abc" {
+			t.Errorf("expected cursor 'abc', got '%s'", query.Get("cursor"))
+		}
+
+		w.WriteHeader(http.StatusOK)
+		if _, err := w.Write([]byte(mockResponses[requestCount])); err != nil {
+			t.Errorf("failed to write response: %v", err)
+		}
+		requestCount++
+	})
+
+	ts := httptest.NewServer(handler)
+	defer ts.Close()
+
+	token := "test-token"
+	client, err := NewClient(&ts.URL, &token)
+	require.NoError(t, err)
+
+	workflows, err := client.GetWorkflowsByName("Order Sync")
+	require.NoError(t, err)
+	require.Len(t, workflows.Data, 2)
+	require.Equal(t, 2, requestCount)
+}
+
+func TestGetWorkflowsByTags(t *testing.T) {
+	handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if tags := r.URL.Query().Get("tags"); tags != "production,billing" {
+			t.Errorf("expected tags filter 'production,billing', got '%s'", tags)
+		}
+
+		w.WriteHeader(http.StatusOK)
+		if _, err := w.Write([]byte(`{"data": [{"id": "3LODqkaWPmYOi0FA", "name": "Order Sync"}], "nextCursor": null}`)); err != nil {
+			t.Errorf("failed to write response: %v", err)
+		}
+	})
+
+	ts := httptest.NewServer(handler)
+	defer ts.Close()
+
+	token := "test-token"
+	client, err := NewClient(&ts.URL, &token)
+	require.NoError(t, err)
+
+	workflows, err := client.GetWorkflowsByTags([]string{"production", "billing"})
+	require.NoError(t, err)
+	require.Len(t, workflows.Data, 1)
+	require.Equal(t, "3LODqkaWPmYOi0FA", workflows.Data[0].ID)
+}
+
+func TestWorkflowLifecycleAgainstFakeServer(t *testing.T) {
+	server := n8ntest.NewServer(t, "test-token")
+
+	client, err := NewClient(&server.URL, &server.APIKey)
+	require.NoError(t, err)
+
+	created, err := client.CreateWorkflow(&CreateWorkflowRequest{
+		Name: "Scheduled Sync",
+		Nodes: []Node{
+			{
+				Name:        "Every Hour",
+				Type:        "n8n-nodes-base.scheduleTrigger",
+				TypeVersion: 1.2,
+				Position:    []float64{0, 0},
+				Parameters:  map[string]interface{}{},
+				Credentials: map[string]CredentialRef{"api": {ID: "1", Name: "API"}},
+			},
+		},
+		Connections: map[string]Connection{},
+		Settings:    Settings{ExecutionOrder: "v1"},
+	})
+	require.NoError(t, err)
+	require.NotEmpty(t, created.ID)
+	require.False(t, created.Active)
+	require.Equal(t, map[string]CredentialRef{"api": {ID: "1", Name: "API"}}, created.Nodes[0].Credentials)
+
+	activated, err := client.ActivateWorkflow(created.ID)
+	require.NoError(t, err)
+	require.True(t, activated.Active)
+
+	updated, err := client.UpdateWorkflow(created.ID, &UpdateWorkflowRequest{
+		Name:        "Hourly Sync",
+		Nodes:       created.Nodes,
+		Connections: created.Connections,
+		Settings:    created.Settings,
+	})
+	require.NoError(t, err)
+	require.Equal(t, "Hourly Sync", updated.Name)
+	require.NotEqual(t, created.VersionId, updated.VersionId)
+
+	deactivated, err := client.DeactivateWorkflow(created.ID)
+	require.NoError(t, err)
+	require.False(t, deactivated.Active)
+
+	_, err = client.DeleteWorkflow(created.ID)
+	require.NoError(t, err)
+
+	_, err = client.GetWorkflow(created.ID)
+	require.True(t, IsNotFound(err))
+}
+
+func TestActivateWorkflowWithoutTriggerAgainstFakeServer(t *testing.T) {
+	server := n8ntest.NewServer(t, "test-token")
+	workflow := server.AddWorkflow(n8ntest.Workflow{
+		Name:  "Manual",
+		Nodes: json.RawMessage(`[{"name": "Start", "type": "n8n-nodes-base.manualTrigger"}]`),
+	})
+
+	client, err := NewClient(&server.URL, &server.APIKey)
+	require.NoError(t, err)
+
+	_, err = client.ActivateWorkflow(workflow.ID)
+
+	var apiErr *APIError
+	require.ErrorAs(t, err, &apiErr)
+	require.Equal(t, http.StatusBadRequest, apiErr.StatusCode)
+	require.Contains(t, apiErr.Body, "at least one trigger")
+}
+
+func TestGetWorkflowsPaginationAgainstFakeServer(t *testing.T) {
+	server := n8ntest.NewServer(t, "test-token")
+	server.PageSize = 2
+	for _, name := range []string{"One", "Two", "Three", "Two", "Four"} {
+		server.AddWorkflow(n8ntest.Workflow{Name: name})
+	}
+
+	client, err := NewClient(&server.URL, &server.APIKey)
+	require.NoError(t, err)
+
+	workflows, err := client.GetWorkflows()
+	require.NoError(t, err)
+	require.Len(t, workflows.Data, 5)
+
+	named, err := client.GetWorkflowsByName("Two")
+	require.NoError(t, err)
+	require.Len(t, named.Data, 2)
+}
+
+func TestGetWorkflowsWithInvalidTokenAgainstFakeServer(t *testing.T) {
+	server := n8ntest.NewServer(t, "test-token")
+
+	token := "wrong-token"
+	client, err := NewClient(&server.URL, &token)
+	require.NoError(t, err)
+
+	_, err = client.GetWorkflows()
+
+	var apiErr *APIError
+	require.ErrorAs(t, err, &apiErr)
+	require.Equal(t, http.StatusUnauthorized, apiErr.StatusCode)
+}
+
+func TestUpdateWorkflowSettingsAgainstFakeServer(t *testing.T) {
+	server := n8ntest.NewServer(t, "test-token")
+	nodes := `[{"id":"1","name":"Webhook","type":"n8n-nodes-base.webhook","typeVersion":2,"position":[0,0],"parameters":{"path":"hook"},"webhookId":"0c6d8b64"}]`
+	connections := `{"Webhook":{"main":[[]]}}`
+	seeded := server.AddWorkflow(n8ntest.Workflow{
+		Name:        "Orders",
+		Nodes:       json.RawMessage(nodes),
+		Connections: json.RawMessage(connections),
+		Settings:    json.RawMessage(`{"executionOrder":"v1","callerPolicy":"workflowsFromSameOwner"}`),
+		StaticData:  json.RawMessage(`null`),
+	})
+
+	client, err := NewClient(&server.URL, &server.APIKey)
+	require.NoError(t, err)
+
+	updated, err := client.UpdateWorkflowSettings(seeded.ID, func(settings *Settings) {
+		settings.Timezone = "Europe/Berlin"
+		settings.ErrorWorkflow = "VzqKEW0ShTXA5vPj"
+	})
+	require.NoError(t, err)
+	require.Equal(t, "Europe/Berlin", updated.Settings.Timezone)
+	require.Equal(t, "VzqKEW0ShTXA5vPj", updated.Settings.ErrorWorkflow)
+
+	stored, ok := server.Workflow(seeded.ID)
+	require.True(t, ok)
+	require.Equal(t, "Orders", stored.Name)
+	require.JSONEq(t, nodes, string(stored.Nodes))
+	require.JSONEq(t, connections, string(stored.Connections))
+	require.JSONEq(t, `{
+		"saveExecutionProgress": false,
+		"saveManualExecutions": false,
+		"executionOrder": "v1",
+		"timezone": "Europe/Berlin",
+		"errorWorkflow": "VzqKEW0ShTXA5vPj",
+		"callerPolicy": "workflowsFromSameOwner"
+	}`, string(stored.Settings))
+
+	// Settings cleared by the update are sent empty
+	_, err = client.UpdateWorkflowSettings(seeded.ID, func(settings *Settings) {
+		settings.ErrorWorkflow = ""
+	})
+	require.NoError(t, err)
+
+	stored, ok = server.Workflow(seeded.ID)
+	require.True(t, ok)
+	require.JSONEq(t, `{
+		"saveExecutionProgress": false,
+		"saveManualExecutions": false,
+		"executionOrder": "v1",
+		"timezone": "Europe/Berlin",
+		"errorWorkflow": "",
+		"callerPolicy": "workflowsFromSameOwner"
+	}`, string(stored.Settings))
+
+	_, err = client.UpdateWorkflowSettings("missing", func(*Settings) {})
+	require.True(t, IsNotFound(err))
+}
@@ -0,0 +1,151 @@
+// Copyright (c) Arthur Diniz <arthurbdiniz@gmail.com>
+// SPDX-License-Identifier: Apache-2.0
+
+package provider
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"os"
+	"os/exec"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/ephemeralvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var _ ephemeral.EphemeralResource = &apiTokenEphemeralResource{}
+var _ ephemeral.EphemeralResourceWithConfigValidators = &apiTokenEphemeralResource{}
+
+// NewAPITokenEphemeralResource returns a new ephemeral resource.
+func NewAPITokenEphemeralResource() ephemeral.EphemeralResource {
+	return &apiTokenEphemeralResource{}
+}
+
+type apiTokenEphemeralResource struct{}
+
+type apiTokenEphemeralResourceModel struct {
+	File    types.String   `tfsdk:"file"`
+	Command []types.String `tfsdk:"command"`
+	Token   types.String   `tfsdk:"token"`
+}
+
+func (e *apiTokenEphemeralResource) Metadata(_ context.Context, req ephemeral.MetadataRequest, resp *ephemeral.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_api_token"
+}
+
+func (e *apiTokenEphemeralResource) Schema(_ context.Context, _ ephemeral.SchemaRequest, resp *ephemeral.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Read an n8n API token from a file or the output of a command without storing it in the plan or state. " +
+			"The token can be passed to the `token` argument of a provider block. Requires Terraform 1.10 or later.",
+		Attributes: map[string]schema.Attribute{
+			"file": schema.StringAttribute{
+				Optional:    true,
+				Description: "Path of a file holding the token. Exactly one of `file` or `command` must be set.",
+			},
+			"command": schema.ListAttribute{
+				ElementType: types.StringType,
+				Optional:    true,
+				Description: "Program and arguments to run, for example a secret manager CLI; its standard output is the token. " +
+					"The program is run directly, not through a shell. Exactly one of `file` or `command` must be set.",
+				Validators: []validator.List{
+					listvalidator.SizeAtLeast(1),
+				},
+			},
+			"token": schema.StringAttribute{
+				Computed:    true,
+				Sensitive:   true,
+				Description: "The token, without surrounding whitespace.",
+			},
+		},
+	}
+}
+
+func (e *apiTokenEphemeralResource) ConfigValidators(_ context.Context) []ephemeral.ConfigValidator {
+	return []ephemeral.ConfigValidator{
+		ephemeralvalidator.ExactlyOneOf(
+			path.MatchRoot("file"),
+			path.MatchRoot("command"),
+		),
+	}
+}
+
+func (e *apiTokenEphemeralResource) Open(ctx context.Context, req ephemeral.OpenRequest, resp *ephemeral.OpenResponse) {
+	var data apiTokenEphemeralResourceModel
+	diags := req.Config.Get(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var (
+		token     string
+		err       error
+		attribute path.Path
+	)
+	if !data.File.IsNull() {
+		attribute = path.Root("file")
+		token, err = readTokenFromFile(data.File.ValueString())
+	} else {
+		attribute = path.Root("command")
+		command := make([]string, 0, len(data.Command))
+		for _, arg := range data.Command {
+			command = append(command, arg.ValueString())
+		}
+		token, err = readTokenFromCommand(ctx, command)
+	}
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(attribute, "Unable to Read n8n API Token", err.Error())
+		return
+	}
+
+	data.Token = types.StringValue(token)
+	resp.Diagnostics.Append(resp.Result.Set(ctx, &data)...)
+}
+
+// readTokenFromFile returns the token stored in filename.
+func readTokenFromFile(filename string) (string, error) {
+	data, err := os.ReadFile(filename)
+	if err != nil {
+		return "", err
+	}
+
+	token := strings.TrimSpace(string(data))
+	if token == "" {
+		return "", fmt.Errorf("token file %s is empty", filename)
+	}
+	return token, nil
+}
+
+// readTokenFromCommand runs command and returns its standard output as the
+// token. The standard error is included in the error when the command fails.
+func readTokenFromCommand(ctx context.Context, command []string) (string, error) {
+	if len(command) == 0 || command[0] == "" {
+		return "", fmt.Errorf("command is empty")
+	}
+
+	var stdout, stderr bytes.Buffer
+	cmd := exec.CommandContext(ctx, command[0], command[1:]...)
+	cmd.Stdout = &stdout
+	cmd.Stderr = &stderr
+
+	if err := cmd.Run(); err != nil {
+		if message := strings.TrimSpace(stderr.String()); message != "" {
+			return "", fmt.Errorf("command %s failed: %w: %s", command[0], err, message)
+		}
+		return "", fmt.Errorf("command %s failed: %w", command[0], err)
+	}
+
+	token := strings.TrimSpace(stdout.String())
+	if token == "" {
+		return "", fmt.Errorf("command %s printed no token", command[0])
+	}
+	return token, nil
+}
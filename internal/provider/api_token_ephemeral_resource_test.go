@@ -0,0 +1,110 @@
+// Copyright (c) Arthur Diniz <arthurbdiniz@gmail.com>
+// SPDX-License-Identifier: Apache-2.0
+
+package provider
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func openAPIToken(t *testing.T, file interface{}, command []string) (*ephemeral.OpenResponse, apiTokenEphemeralResourceModel) {
+	t.Helper()
+
+	ctx := context.Background()
+	e := NewAPITokenEphemeralResource()
+
+	var schemaResp ephemeral.SchemaResponse
+	e.Schema(ctx, ephemeral.SchemaRequest{}, &schemaResp)
+	require.False(t, schemaResp.Diagnostics.HasError())
+
+	objectType := schemaResp.Schema.Type().TerraformType(ctx)
+
+	commandValue := tftypes.NewValue(tftypes.List{ElementType: tftypes.String}, nil)
+	if command != nil {
+		elements := make([]tftypes.Value, 0, len(command))
+		for _, arg := range command {
+			elements = append(elements, tftypes.NewValue(tftypes.String, arg))
+		}
+		commandValue = tftypes.NewValue(tftypes.List{ElementType: tftypes.String}, elements)
+	}
+
+	raw := tftypes.NewValue(objectType, map[string]tftypes.Value{
+		"file":    tftypes.NewValue(tftypes.String, file),
+		"command": commandValue,
+		"token":   tftypes.NewValue(tftypes.String, nil),
+	})
+
+	req := ephemeral.OpenRequest{Config: tfsdk.Config{Schema: schemaResp.Schema, Raw: raw}}
+	resp := &ephemeral.OpenResponse{Result: tfsdk.EphemeralResultData{Schema: schemaResp.Schema, Raw: raw.Copy()}}
+	e.Open(ctx, req, resp)
+
+	var result apiTokenEphemeralResourceModel
+	if !resp.Diagnostics.HasError() {
+		require.False(t, resp.Result.Get(ctx, &result).HasError())
+	}
+	return resp, result
+}
+
+func TestAPITokenEphemeralResource_File(t *testing.T) {
+	filename := filepath.Join(t.TempDir(), "token")
+	require.NoError(t, os.WriteFile(filename, []byte("file-token\n"), 0o600))
+
+	resp, result := openAPIToken(t, filename, nil)
+
+	require.False(t, resp.Diagnostics.HasError(), resp.Diagnostics)
+	assert.Equal(t, "file-token", result.Token.ValueString())
+}
+
+func TestAPITokenEphemeralResource_Command(t *testing.T) {
+	resp, result := openAPIToken(t, nil, []string{"sh", "-c", "echo '  command-token  '"})
+
+	require.False(t, resp.Diagnostics.HasError(), resp.Diagnostics)
+	assert.Equal(t, "command-token", result.Token.ValueString())
+}
+
+func TestAPITokenEphemeralResource_Errors(t *testing.T) {
+	empty := filepath.Join(t.TempDir(), "empty")
+	require.NoError(t, os.WriteFile(empty, []byte("\n"), 0o600))
+
+	tests := map[string]struct {
+		file     interface{}
+		command  []string
+		expected string
+	}{
+		"missing file": {
+			file:     filepath.Join(t.TempDir(), "missing"),
+			expected: "no such file or directory",
+		},
+		"empty file": {
+			file:     empty,
+			expected: "is empty",
+		},
+		"failing command": {
+			command:  []string{"sh", "-c", "echo 'not logged in' >&2; exit 3"},
+			expected: "command sh failed: exit status 3: not logged in",
+		},
+		"silent command": {
+			command:  []string{"true"},
+			expected: "command true printed no token",
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			resp, _ := openAPIToken(t, tc.file, tc.command)
+
+			require.True(t, resp.Diagnostics.HasError())
+			assert.Equal(t, "Unable to Read n8n API Token", resp.Diagnostics[0].Summary())
+			assert.Contains(t, resp.Diagnostics[0].Detail(), tc.expected)
+		})
+	}
+}
@@ -0,0 +1,36 @@
+// Copyright (c) Arthur Diniz <arthurbdiniz@gmail.com>
+// SPDX-License-Identifier: Apache-2.0
+
+package provider
+
+import (
+	"context"
+
+	"github.com/arthurbdiniz/terraform-provider-n8n/internal/pkg/n8n-client-go"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// tflogLogger forwards the n8n client debug records to the provider log, so
+// requests show up with TF_LOG=DEBUG. Records are written to the logger of
+// the request context, so they carry the fields of the Terraform operation
+// that made the request.
+type tflogLogger struct {
+	token string
+}
+
+var _ n8n.Logger = tflogLogger{}
+
+// newClientLogger returns a client Logger writing to the logger carried by
+// the context of each request. The token is masked in every message and
+// field as a second line of defence behind the client's own redaction.
+func newClientLogger(token string) n8n.Logger {
+	return tflogLogger{token: token}
+}
+
+func (l tflogLogger) Debug(ctx context.Context, msg string, fields map[string]interface{}) {
+	if l.token != "" {
+		ctx = tflog.MaskAllFieldValuesStrings(ctx, l.token)
+		ctx = tflog.MaskMessageStrings(ctx, l.token)
+	}
+	tflog.Debug(ctx, msg, fields)
+}
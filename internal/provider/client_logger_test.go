@@ -0,0 +1,40 @@
+// Copyright (c) Arthur Diniz <arthurbdiniz@gmail.com>
+// SPDX-License-Identifier: Apache-2.0
+
+package provider
+
+import (
+	"bytes"
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-log/tflogtest"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestClientLogger(t *testing.T) {
+	var output bytes.Buffer
+	ctx := tflogtest.RootLogger(context.Background(), &output)
+	ctx = tflog.SetField(ctx, "operation", "read")
+
+	logger := newClientLogger("secret-token")
+	logger.Debug(ctx, "n8n API request", map[string]interface{}{
+		"method": "GET",
+		"url":    "http://localhost:5678/api/v1/workflows?token=secret-token",
+		"status": 200,
+	})
+
+	entries, err := tflogtest.MultilineJSONDecode(&output)
+	require.NoError(t, err)
+	require.Len(t, entries, 1)
+
+	entry := entries[0]
+	assert.Equal(t, "debug", entry["@level"])
+	assert.Equal(t, "n8n API request", entry["@message"])
+	assert.Equal(t, "GET", entry["method"])
+	assert.Equal(t, float64(200), entry["status"])
+	assert.Equal(t, "http://localhost:5678/api/v1/workflows?token=***", entry["url"])
+	assert.Equal(t, "read", entry["operation"], "the record carries the fields of the request context")
+}
@@ -0,0 +1,450 @@
+// Copyright (c) Arthur Diniz <arthurbdiniz@gmail.com>
+// SPDX-License-Identifier: Apache-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"sort"
+
+	"github.com/arthurbdiniz/terraform-provider-n8n/internal/pkg/n8n-client-go"
+	"github.com/hashicorp/terraform-plugin-framework-validators/resourcevalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                     = &errorWorkflowBindingResource{}
+	_ resource.ResourceWithConfigure        = &errorWorkflowBindingResource{}
+	_ resource.ResourceWithConfigValidators = &errorWorkflowBindingResource{}
+	_ resource.ResourceWithModifyPlan       = &errorWorkflowBindingResource{}
+)
+
+// NewErrorWorkflowBindingResource is a helper function to simplify the provider implementation.
+func NewErrorWorkflowBindingResource() resource.Resource {
+	return &errorWorkflowBindingResource{}
+}
+
+// errorWorkflowBindingResource is the resource implementation.
+type errorWorkflowBindingResource struct {
+	client *n8n.Client
+}
+
+// errorWorkflowBindingResourceModel maps the resource schema data.
+type errorWorkflowBindingResourceModel struct {
+	ID                 types.String `tfsdk:"id"`
+	ErrorWorkflowID    types.String `tfsdk:"error_workflow_id"`
+	WorkflowIDs        types.Set    `tfsdk:"workflow_ids"`
+	Tags               types.Set    `tfsdk:"tags"`
+	BoundWorkflowIDs   types.Set    `tfsdk:"bound_workflow_ids"`
+	DriftedWorkflowIDs types.Set    `tfsdk:"drifted_workflow_ids"`
+}
+
+// bindingTarget is a workflow the binding applies to.
+type bindingTarget struct {
+	ID            string
+	ErrorWorkflow string
+	Found         bool
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *errorWorkflowBindingResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Add a nil check when handling ProviderData because Terraform
+	// sets that data after it calls the ConfigureProvider RPC.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*n8n.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *n8n.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+// Metadata returns the resource type name.
+func (r *errorWorkflowBindingResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_error_workflow_binding"
+}
+
+// Schema defines the schema for the resource.
+func (r *errorWorkflowBindingResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Points the error workflow setting of many workflows at one workflow, such as a central alerting workflow. " +
+			"The workflows are selected by ID, by tag, or both. Workflows tagged after the last apply, and workflows whose " +
+			"error workflow was changed in n8n, are listed in `drifted_workflow_ids` and bound again on the next apply. " +
+			"Destroying the resource clears the error workflow of the bound workflows that still point at it.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:    true,
+				Description: "Identifier of the resource, equal to `error_workflow_id`.",
+			},
+			"error_workflow_id": schema.StringAttribute{
+				Required:    true,
+				Description: "ID of the workflow to run when an execution of a bound workflow fails.",
+				Validators:  workflowIDValidators(),
+			},
+			"workflow_ids": schema.SetAttribute{
+				ElementType: types.StringType,
+				Optional:    true,
+				Description: "IDs of the workflows to bind. At least one of `workflow_ids` or `tags` must be set.",
+				Validators: []validator.Set{
+					setvalidator.ValueStringsAre(workflowIDValidators()...),
+				},
+			},
+			"tags": schema.SetAttribute{
+				ElementType: types.StringType,
+				Optional:    true,
+				Description: "Names of tags; every workflow having at least one of them is bound. " +
+					"At least one of `workflow_ids` or `tags` must be set.",
+				Validators: []validator.Set{
+					setvalidator.SizeAtLeast(1),
+					setvalidator.ValueStringsAre(
+						stringvalidator.LengthAtLeast(1),
+						stringvalidator.RegexMatches(regexp.MustCompile(`^[^,]*$`), "must not contain commas"),
+					),
+				},
+			},
+			"bound_workflow_ids": schema.SetAttribute{
+				ElementType: types.StringType,
+				Computed:    true,
+				Description: "IDs of the workflows the binding applies to, from `workflow_ids` and the workflows matching `tags`. " +
+					"The error workflow itself is never bound.",
+			},
+			"drifted_workflow_ids": schema.SetAttribute{
+				ElementType: types.StringType,
+				Computed:    true,
+				Description: "IDs of the bound workflows whose error workflow differed from `error_workflow_id` at the last refresh. " +
+					"Empty after an apply.",
+			},
+		},
+	}
+}
+
+func (r *errorWorkflowBindingResource) ConfigValidators(_ context.Context) []resource.ConfigValidator {
+	return []resource.ConfigValidator{
+		resourcevalidator.AtLeastOneOf(
+			path.MatchRoot("workflow_ids"),
+			path.MatchRoot("tags"),
+		),
+	}
+}
+
+// ModifyPlan plans an update when workflows drifted since the last apply, so
+// that they are bound again, and keeps the bound workflows from the prior
+// state when nothing changes.
+func (r *errorWorkflowBindingResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var plan errorWorkflowBindingResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	plan.ID = plan.ErrorWorkflowID
+	plan.DriftedWorkflowIDs = types.SetValueMust(types.StringType, nil)
+	plan.BoundWorkflowIDs = types.SetUnknown(types.StringType)
+
+	if !req.State.Raw.IsNull() {
+		var state errorWorkflowBindingResourceModel
+		resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		// The bound workflows are only known in advance when nothing is
+		// applied; otherwise newly tagged workflows may still appear.
+		if plan.ErrorWorkflowID.Equal(state.ErrorWorkflowID) &&
+			plan.WorkflowIDs.Equal(state.WorkflowIDs) &&
+			plan.Tags.Equal(state.Tags) &&
+			len(state.DriftedWorkflowIDs.Elements()) == 0 {
+			plan.BoundWorkflowIDs = state.BoundWorkflowIDs
+		}
+	}
+
+	resp.Diagnostics.Append(resp.Plan.Set(ctx, plan)...)
+}
+
+// Create binds the selected workflows and sets the initial Terraform state.
+func (r *errorWorkflowBindingResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	if !checkClient(r.client, &resp.Diagnostics) {
+		return
+	}
+
+	var plan errorWorkflowBindingResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// The workflows bound before an error are saved as well, so they are
+	// unbound when the tainted resource is replaced or destroyed.
+	resp.Diagnostics.Append(r.bind(ctx, &plan)...)
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (r *errorWorkflowBindingResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	if !checkClient(r.client, &resp.Diagnostics) {
+		return
+	}
+
+	var state errorWorkflowBindingResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	targets, diags := r.resolveTargets(ctx, state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	errorWorkflowID := state.ErrorWorkflowID.ValueString()
+	bound := make([]string, 0, len(targets))
+	drifted := []string{}
+	for _, target := range targets {
+		bound = append(bound, target.ID)
+		if !target.Found || target.ErrorWorkflow != errorWorkflowID {
+			drifted = append(drifted, target.ID)
+		}
+	}
+
+	state.ID = state.ErrorWorkflowID
+	state.BoundWorkflowIDs = stringSet(bound)
+	state.DriftedWorkflowIDs = stringSet(drifted)
+
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Update binds the selected workflows, unbinds the ones no longer selected and
+// sets the updated Terraform state on success.
+func (r *errorWorkflowBindingResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	if !checkClient(r.client, &resp.Diagnostics) {
+		return
+	}
+
+	var plan, state errorWorkflowBindingResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(r.bind(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	stillBound := make(map[string]bool)
+	for _, id := range setStrings(plan.BoundWorkflowIDs) {
+		stillBound[id] = true
+	}
+	var released []string
+	for _, id := range setStrings(state.BoundWorkflowIDs) {
+		if !stillBound[id] {
+			released = append(released, id)
+		}
+	}
+	resp.Diagnostics.Append(r.unbind(ctx, released, state.ErrorWorkflowID.ValueString())...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	diags := resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Delete clears the error workflow of the bound workflows still pointing at it.
+func (r *errorWorkflowBindingResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	if !checkClient(r.client, &resp.Diagnostics) {
+		return
+	}
+
+	var state errorWorkflowBindingResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(r.unbind(ctx, setStrings(state.BoundWorkflowIDs), state.ErrorWorkflowID.ValueString())...)
+}
+
+// bind points the error workflow of every target of the model at the
+// configured workflow and records the bound workflows in the model. On error,
+// the model holds the workflows bound so far, and the targets that could not
+// be bound are recorded as drifted.
+func (r *errorWorkflowBindingResource) bind(ctx context.Context, model *errorWorkflowBindingResourceModel) diag.Diagnostics {
+	model.ID = model.ErrorWorkflowID
+	model.BoundWorkflowIDs = types.SetValueMust(types.StringType, nil)
+	model.DriftedWorkflowIDs = types.SetValueMust(types.StringType, nil)
+
+	targets, diags := r.resolveTargets(ctx, *model)
+	if diags.HasError() {
+		return diags
+	}
+
+	errorWorkflowID := model.ErrorWorkflowID.ValueString()
+	bound := make([]string, 0, len(targets))
+	failed := []string{}
+	for _, target := range targets {
+		if !target.Found {
+			diags.AddAttributeError(
+				path.Root("workflow_ids"),
+				"Workflow Not Found",
+				fmt.Sprintf("No workflow with ID %q was found.", target.ID),
+			)
+			failed = append(failed, target.ID)
+			continue
+		}
+
+		if target.ErrorWorkflow != errorWorkflowID {
+			_, err := r.client.WithContext(ctx).UpdateWorkflowSettings(target.ID, func(settings *n8n.Settings) {
+				settings.ErrorWorkflow = errorWorkflowID
+			})
+			if err != nil {
+				diags.AddError("Error updating workflow settings", fmt.Sprintf("Workflow %s: %s", target.ID, err))
+				failed = append(failed, target.ID)
+				continue
+			}
+		}
+		bound = append(bound, target.ID)
+	}
+
+	model.BoundWorkflowIDs = stringSet(bound)
+	model.DriftedWorkflowIDs = stringSet(failed)
+	return diags
+}
+
+// unbind clears the error workflow of the given workflows when it still
+// points at errorWorkflowID. Workflows that no longer exist are skipped.
+func (r *errorWorkflowBindingResource) unbind(ctx context.Context, workflowIDs []string, errorWorkflowID string) diag.Diagnostics {
+	var diags diag.Diagnostics
+	for _, id := range workflowIDs {
+		workflow, err := r.client.WithContext(ctx).GetWorkflow(id)
+		if n8n.IsNotFound(err) {
+			continue
+		}
+		if err != nil {
+			diags.AddError("Error retrieving workflow", fmt.Sprintf("Workflow %s: %s", id, err))
+			continue
+		}
+		if workflow.Settings.ErrorWorkflow != errorWorkflowID {
+			continue
+		}
+
+		_, err = r.client.WithContext(ctx).UpdateWorkflowSettings(id, func(settings *n8n.Settings) {
+			settings.ErrorWorkflow = ""
+		})
+		if err != nil && !n8n.IsNotFound(err) {
+			diags.AddError("Error updating workflow settings", fmt.Sprintf("Workflow %s: %s", id, err))
+		}
+	}
+	return diags
+}
+
+// resolveTargets returns the workflows selected by the model, sorted by ID.
+// Workflows listed in workflow_ids that do not exist are returned with Found
+// unset. The error workflow itself is never a target.
+func (r *errorWorkflowBindingResource) resolveTargets(ctx context.Context, model errorWorkflowBindingResourceModel) ([]bindingTarget, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	errorWorkflowID := model.ErrorWorkflowID.ValueString()
+	targets := make(map[string]bindingTarget)
+
+	var tags []string
+	if !model.Tags.IsNull() {
+		diags.Append(model.Tags.ElementsAs(ctx, &tags, false)...)
+	}
+	var workflowIDs []string
+	if !model.WorkflowIDs.IsNull() {
+		diags.Append(model.WorkflowIDs.ElementsAs(ctx, &workflowIDs, false)...)
+	}
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	if len(tags) > 0 {
+		workflows, err := r.client.WithContext(ctx).GetWorkflowsByTags(tags)
+		if err != nil {
+			diags.AddError("Error listing workflows", err.Error())
+			return nil, diags
+		}
+		for _, workflow := range workflows.Data {
+			targets[workflow.ID] = bindingTarget{ID: workflow.ID, ErrorWorkflow: workflow.Settings.ErrorWorkflow, Found: true}
+		}
+	}
+
+	for _, id := range workflowIDs {
+		if _, ok := targets[id]; ok {
+			continue
+		}
+		workflow, err := r.client.WithContext(ctx).GetWorkflow(id)
+		if n8n.IsNotFound(err) {
+			targets[id] = bindingTarget{ID: id}
+			continue
+		}
+		if err != nil {
+			diags.AddError("Error retrieving workflow", fmt.Sprintf("Workflow %s: %s", id, err))
+			return nil, diags
+		}
+		targets[id] = bindingTarget{ID: id, ErrorWorkflow: workflow.Settings.ErrorWorkflow, Found: true}
+	}
+
+	delete(targets, errorWorkflowID)
+
+	result := make([]bindingTarget, 0, len(targets))
+	for _, target := range targets {
+		result = append(result, target)
+	}
+	sort.Slice(result, func(i, j int) bool { return result[i].ID < result[j].ID })
+	return result, diags
+}
+
+// stringSet returns a set value holding the given strings.
+func stringSet(values []string) types.Set {
+	elements := make([]attr.Value, 0, len(values))
+	for _, value := range values {
+		elements = append(elements, types.StringValue(value))
+	}
+	return types.SetValueMust(types.StringType, elements)
+}
+
+// setStrings returns the strings of a known set value, and nil otherwise.
+func setStrings(set types.Set) []string {
+	if set.IsNull() || set.IsUnknown() {
+		return nil
+	}
+	values := make([]string, 0, len(set.Elements()))
+	for _, element := range set.Elements() {
+		if value, ok := element.(types.String); ok {
+			values = append(values, value.ValueString())
+		}
+	}
+	return values
+}
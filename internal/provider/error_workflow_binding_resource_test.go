@@ -0,0 +1,240 @@
+// Copyright (c) Arthur Diniz <arthurbdiniz@gmail.com>
+// SPDX-License-Identifier: Apache-2.0
+
+package provider
+
+import (
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/arthurbdiniz/terraform-provider-n8n/internal/config"
+	"github.com/arthurbdiniz/terraform-provider-n8n/internal/pkg/n8n-client-go"
+	"github.com/arthurbdiniz/terraform-provider-n8n/internal/pkg/n8n-client-go/n8ntest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/stretchr/testify/require"
+)
+
+func TestErrorWorkflowBindingResource(t *testing.T) {
+	server := newTestServer(t)
+	client, err := n8n.NewClient(&server.URL, &config.ApiToken)
+	require.NoError(t, err)
+	prod := server.AddTag(n8ntest.Tag{Name: "prod"})
+
+	addWorkflow := func(name string, settings string, tags ...n8ntest.Tag) n8ntest.Workflow {
+		return server.AddWorkflow(n8ntest.Workflow{
+			Name:        name,
+			Nodes:       json.RawMessage(`[]`),
+			Connections: json.RawMessage(`{}`),
+			Settings:    json.RawMessage(settings),
+			Tags:        tags,
+		})
+	}
+	alerting := addWorkflow("Alerting", `{}`, prod)
+	orders := addWorkflow("Orders", `{"timezone":"UTC"}`)
+	billing := addWorkflow("Billing", `{}`, prod)
+	reports := addWorkflow("Reports", `{"errorWorkflow":"OldAlerting00001"}`, prod)
+	staging := addWorkflow("Staging", `{}`)
+
+	var newcomer n8ntest.Workflow
+
+	errorWorkflowOf := func(id string) string {
+		stored, ok := server.Workflow(id)
+		require.True(t, ok, "workflow %s not found", id)
+		var settings struct {
+			ErrorWorkflow string `json:"errorWorkflow"`
+		}
+		require.NoError(t, json.Unmarshal(stored.Settings, &settings))
+		return settings.ErrorWorkflow
+	}
+	checkRemote := func(expected map[string]string) resource.TestCheckFunc {
+		return func(*terraform.State) error {
+			for id, errorWorkflow := range expected {
+				if actual := errorWorkflowOf(id); actual != errorWorkflow {
+					return fmt.Errorf("workflow %s: expected error workflow %q, got %q", id, errorWorkflow, actual)
+				}
+			}
+			return nil
+		}
+	}
+
+	byTag := fmt.Sprintf(`
+		resource "n8n_error_workflow_binding" "test" {
+			error_workflow_id = %q
+			workflow_ids      = [%q]
+			tags              = ["prod"]
+		}
+	`, alerting.ID, orders.ID)
+	byID := fmt.Sprintf(`
+		resource "n8n_error_workflow_binding" "test" {
+			error_workflow_id = %q
+			workflow_ids      = [%q]
+		}
+	`, alerting.ID, orders.ID)
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testProtoV6ProviderFactoriesForURL(t, server.URL),
+		CheckDestroy: func(*terraform.State) error {
+			require.Empty(t, errorWorkflowOf(orders.ID), "destroy must clear the error workflow")
+			return nil
+		},
+		Steps: []resource.TestStep{
+			// Create binds the listed and tagged workflows, except the error workflow itself
+			{
+				Config: byTag,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("n8n_error_workflow_binding.test", "id", alerting.ID),
+					resource.TestCheckResourceAttr("n8n_error_workflow_binding.test", "bound_workflow_ids.#", "3"),
+					resource.TestCheckTypeSetElemAttr("n8n_error_workflow_binding.test", "bound_workflow_ids.*", orders.ID),
+					resource.TestCheckTypeSetElemAttr("n8n_error_workflow_binding.test", "bound_workflow_ids.*", billing.ID),
+					resource.TestCheckTypeSetElemAttr("n8n_error_workflow_binding.test", "bound_workflow_ids.*", reports.ID),
+					resource.TestCheckResourceAttr("n8n_error_workflow_binding.test", "drifted_workflow_ids.#", "0"),
+					checkRemote(map[string]string{
+						alerting.ID: "",
+						orders.ID:   alerting.ID,
+						billing.ID:  alerting.ID,
+						reports.ID:  alerting.ID,
+						staging.ID:  "",
+					}),
+				),
+			},
+			// Workflows tagged after the apply are reported as drifted
+			{
+				PreConfig: func() {
+					newcomer = addWorkflow("Newcomer", `{}`, prod)
+				},
+				Config:             byTag,
+				PlanOnly:           true,
+				ExpectNonEmptyPlan: true,
+			},
+			{
+				RefreshState:       true,
+				ExpectNonEmptyPlan: true,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("n8n_error_workflow_binding.test", "drifted_workflow_ids.#", "1"),
+					func(s *terraform.State) error {
+						return resource.TestCheckTypeSetElemAttr("n8n_error_workflow_binding.test", "drifted_workflow_ids.*", newcomer.ID)(s)
+					},
+				),
+			},
+			// Apply binds them
+			{
+				Config: byTag,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("n8n_error_workflow_binding.test", "bound_workflow_ids.#", "4"),
+					resource.TestCheckResourceAttr("n8n_error_workflow_binding.test", "drifted_workflow_ids.#", "0"),
+					func(s *terraform.State) error {
+						return checkRemote(map[string]string{newcomer.ID: alerting.ID})(s)
+					},
+				),
+			},
+			// Workflows no longer selected are unbound
+			{
+				Config: byID,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("n8n_error_workflow_binding.test", "bound_workflow_ids.#", "1"),
+					resource.TestCheckTypeSetElemAttr("n8n_error_workflow_binding.test", "bound_workflow_ids.*", orders.ID),
+					checkRemote(map[string]string{
+						orders.ID:  alerting.ID,
+						billing.ID: "",
+						reports.ID: "",
+					}),
+				),
+			},
+			// Changes made in n8n are drift
+			{
+				PreConfig: func() {
+					_, err := client.UpdateWorkflowSettings(orders.ID, func(settings *n8n.Settings) {
+						settings.ErrorWorkflow = "OldAlerting00001"
+					})
+					require.NoError(t, err)
+				},
+				Config:             byID,
+				PlanOnly:           true,
+				ExpectNonEmptyPlan: true,
+			},
+			{
+				Config: byID,
+				Check:  checkRemote(map[string]string{orders.ID: alerting.ID}),
+			},
+		},
+	})
+}
+
+func TestErrorWorkflowBindingResource_WorkflowNotFound(t *testing.T) {
+	server := newTestServer(t)
+	orders := server.AddWorkflow(n8ntest.Workflow{
+		Name:        "Orders",
+		Nodes:       json.RawMessage(`[]`),
+		Connections: json.RawMessage(`{}`),
+		Settings:    json.RawMessage(`{}`),
+	})
+
+	errorWorkflowOf := func() string {
+		stored, ok := server.Workflow(orders.ID)
+		require.True(t, ok)
+		var settings struct {
+			ErrorWorkflow string `json:"errorWorkflow"`
+		}
+		require.NoError(t, json.Unmarshal(stored.Settings, &settings))
+		return settings.ErrorWorkflow
+	}
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testProtoV6ProviderFactoriesForURL(t, server.URL),
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+					resource "n8n_error_workflow_binding" "test" {
+						error_workflow_id = "VzqKEW0ShTXA5vPj"
+						workflow_ids      = [%q, "4242"]
+					}
+				`, orders.ID),
+				ExpectError: regexp.MustCompile(`No workflow with ID "4242" was found`),
+			},
+			// The workflows bound before the error are in the state, so
+			// removing the binding unbinds them
+			{
+				PreConfig: func() {
+					require.Equal(t, "VzqKEW0ShTXA5vPj", errorWorkflowOf())
+				},
+				Config: `data "n8n_workflows" "all" {}`,
+				Check: func(*terraform.State) error {
+					require.Empty(t, errorWorkflowOf())
+					return nil
+				},
+			},
+		},
+	})
+}
+
+func TestErrorWorkflowBindingResource_Validation(t *testing.T) {
+	server := newUnreachableAPI(t)
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testProtoV6ProviderFactoriesForURL(t, server.URL),
+		Steps: []resource.TestStep{
+			{
+				Config: `
+					resource "n8n_error_workflow_binding" "test" {
+						error_workflow_id = "VzqKEW0ShTXA5vPj"
+					}
+				`,
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile(`At least one of these attributes must be configured`),
+			},
+			{
+				Config: `
+					resource "n8n_error_workflow_binding" "test" {
+						error_workflow_id = "VzqKEW0ShTXA5vPj"
+						tags              = ["prod,staging"]
+					}
+				`,
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile(`must not contain commas`),
+			},
+		},
+	})
+}
@@ -4,17 +4,33 @@
 package provider
 
 import (
+	"bytes"
 	"encoding/json"
+	"errors"
 	"fmt"
+	"io"
+	"math/big"
+	"sort"
+	"strings"
 
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 )
 
 func ConvertToTerraformList(input map[string]interface{}) ([]parameterModel, error) {
 	var paramList []parameterModel
 
+	// Sort the keys so the list order is stable between reads
+	keys := make([]string, 0, len(input))
+	for k := range input {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
 	// Iterate over the input map to convert each element
-	for k, v := range input {
+	for _, k := range keys {
+		v := input[k]
 		var valueStr string
 		var valueType string
 
@@ -48,6 +64,20 @@ func ConvertToTerraformList(input map[string]interface{}) ([]parameterModel, err
 	return paramList, nil
 }
 
+func ConvertParametersToTerraformString(input map[string]interface{}) (types.String, error) {
+	if input == nil {
+		input = map[string]interface{}{}
+	}
+
+	data, err := json.Marshal(input) // Map keys are sorted by encoding/json
+
+	if err != nil {
+		return types.StringNull(), err
+	}
+
+	return types.StringValue(string(data)), nil
+}
+
 func ConvertConnectionsToTerraformMap(connections interface{}) (types.String, error) {
 	data, err := json.Marshal(connections) // Convert to JSON string
 
@@ -57,3 +87,185 @@ func ConvertConnectionsToTerraformMap(connections interface{}) (types.String, er
 
 	return types.StringValue(string(data)), nil
 }
+
+func ConvertRawJSONToTerraformString(raw json.RawMessage) (types.String, error) {
+	if len(raw) == 0 || string(raw) == "null" {
+		return types.StringNull(), nil
+	}
+
+	var buf bytes.Buffer
+	if err := json.Compact(&buf, raw); err != nil {
+		return types.StringNull(), err
+	}
+
+	return types.StringValue(buf.String()), nil
+}
+
+// ConvertJSONToTerraformDynamic decodes a JSON document into a Terraform value,
+// mapping objects to object values and arrays to tuple values so documents
+// with mixed element types are preserved exactly.
+func ConvertJSONToTerraformDynamic(data []byte) (types.Dynamic, error) {
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	decoder.UseNumber()
+
+	var input interface{}
+	if err := decoder.Decode(&input); err != nil {
+		return types.DynamicNull(), err
+	}
+
+	if err := decoder.Decode(&struct{}{}); !errors.Is(err, io.EOF) {
+		return types.DynamicNull(), fmt.Errorf("unexpected data after the top-level JSON value")
+	}
+
+	value, err := convertJSONValue(input)
+	if err != nil {
+		return types.DynamicNull(), err
+	}
+
+	return types.DynamicValue(value), nil
+}
+
+func convertJSONValue(input interface{}) (attr.Value, error) {
+	switch val := input.(type) {
+	case nil:
+		return types.DynamicNull(), nil
+	case bool:
+		return types.BoolValue(val), nil
+	case string:
+		return types.StringValue(val), nil
+	case json.Number:
+		number, _, err := big.ParseFloat(string(val), 10, 512, big.ToNearestEven)
+		if err != nil {
+			return nil, err
+		}
+		return types.NumberValue(number), nil
+	case []interface{}:
+		elemTypes := make([]attr.Type, 0, len(val))
+		elems := make([]attr.Value, 0, len(val))
+		for _, item := range val {
+			elem, err := convertJSONValue(item)
+			if err != nil {
+				return nil, err
+			}
+			elemTypes = append(elemTypes, elem.Type(nil))
+			elems = append(elems, elem)
+		}
+		tuple, diags := types.TupleValue(elemTypes, elems)
+		return tuple, diagsToError(diags)
+	case map[string]interface{}:
+		attrTypes := make(map[string]attr.Type, len(val))
+		attrs := make(map[string]attr.Value, len(val))
+		for k, item := range val {
+			elem, err := convertJSONValue(item)
+			if err != nil {
+				return nil, err
+			}
+			attrTypes[k] = elem.Type(nil)
+			attrs[k] = elem
+		}
+		object, diags := types.ObjectValue(attrTypes, attrs)
+		return object, diagsToError(diags)
+	default:
+		return nil, fmt.Errorf("unsupported JSON value of type %T", input)
+	}
+}
+
+// ConvertTerraformValueToJSON converts a Terraform value into its JSON
+// equivalent. Objects and maps become JSON objects, while lists, sets and
+// tuples become JSON arrays. Unknown values cannot be represented and
+// return an error.
+func ConvertTerraformValueToJSON(value attr.Value) (interface{}, error) {
+	if value == nil || value.IsNull() {
+		return nil, nil
+	}
+
+	if value.IsUnknown() {
+		return nil, fmt.Errorf("cannot convert an unknown value to JSON")
+	}
+
+	switch val := value.(type) {
+	case types.Dynamic:
+		return ConvertTerraformValueToJSON(val.UnderlyingValue())
+	case types.String:
+		return val.ValueString(), nil
+	case types.Bool:
+		return val.ValueBool(), nil
+	case types.Int64:
+		return val.ValueInt64(), nil
+	case types.Float64:
+		return val.ValueFloat64(), nil
+	case types.Number:
+		return bigFloatToJSONNumber(val.ValueBigFloat()), nil
+	case types.Object:
+		return convertTerraformMapToJSON(val.Attributes())
+	case types.Map:
+		return convertTerraformMapToJSON(val.Elements())
+	case types.List:
+		return convertTerraformSliceToJSON(val.Elements())
+	case types.Set:
+		return convertTerraformSliceToJSON(val.Elements())
+	case types.Tuple:
+		return convertTerraformSliceToJSON(val.Elements())
+	default:
+		return nil, fmt.Errorf("unsupported Terraform value of type %T", value)
+	}
+}
+
+func convertTerraformMapToJSON(elems map[string]attr.Value) (interface{}, error) {
+	result := make(map[string]interface{}, len(elems))
+	for k, elem := range elems {
+		v, err := ConvertTerraformValueToJSON(elem)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", k, err)
+		}
+		result[k] = v
+	}
+	return result, nil
+}
+
+func convertTerraformSliceToJSON(elems []attr.Value) (interface{}, error) {
+	result := make([]interface{}, 0, len(elems))
+	for i, elem := range elems {
+		v, err := ConvertTerraformValueToJSON(elem)
+		if err != nil {
+			return nil, fmt.Errorf("[%d]: %w", i, err)
+		}
+		result = append(result, v)
+	}
+	return result, nil
+}
+
+func bigFloatToJSONNumber(number *big.Float) json.Number {
+	if number.IsInt() {
+		integer, _ := number.Int(nil)
+		return json.Number(integer.String())
+	}
+	return json.Number(number.Text('g', -1))
+}
+
+// MarshalCanonicalJSON encodes the value as compact JSON with object keys
+// sorted and without HTML escaping, so equal documents always produce the
+// same string.
+func MarshalCanonicalJSON(value interface{}) (string, error) {
+	var buf bytes.Buffer
+	encoder := json.NewEncoder(&buf)
+	encoder.SetEscapeHTML(false)
+
+	if err := encoder.Encode(value); err != nil {
+		return "", err
+	}
+
+	return strings.TrimSuffix(buf.String(), "\n"), nil
+}
+
+func diagsToError(diags diag.Diagnostics) error {
+	if !diags.HasError() {
+		return nil
+	}
+
+	var messages []string
+	for _, d := range diags.Errors() {
+		messages = append(messages, d.Summary()+": "+d.Detail())
+	}
+	return errors.New(strings.Join(messages, "; "))
+}
@@ -4,6 +4,7 @@
 package provider
 
 import (
+	"encoding/json"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-framework/types"
@@ -44,6 +45,54 @@ func TestConvertToTerraformList_UnknownType(t *testing.T) {
 	assert.Equal(t, "[unexpected type]", result[0].Value.ValueString()) // fmt.Sprintf("%v", []string{...})
 }
 
+func TestConvertToTerraformList_SortedByKey(t *testing.T) {
+	input := map[string]interface{}{
+		"url":     "https://example.com",
+		"method":  "GET",
+		"options": map[string]interface{}{},
+		"auth":    "none",
+	}
+
+	for i := 0; i < 10; i++ {
+		result, err := ConvertToTerraformList(input)
+		assert.NoError(t, err)
+
+		var keys []string
+		for _, p := range result {
+			keys = append(keys, p.Key.ValueString())
+		}
+		assert.Equal(t, []string{"auth", "method", "options", "url"}, keys)
+	}
+}
+
+func TestConvertParametersToTerraformString(t *testing.T) {
+	input := map[string]interface{}{
+		"values": map[string]interface{}{
+			"string": []interface{}{
+				map[string]interface{}{"name": "key", "value": "value"},
+			},
+		},
+		"keepOnlySet": true,
+	}
+
+	expected := `{"keepOnlySet":true,"values":{"string":[{"name":"key","value":"value"}]}}`
+
+	result, err := ConvertParametersToTerraformString(input)
+	assert.NoError(t, err)
+	assert.Equal(t, types.StringValue(expected), result)
+}
+
+func TestConvertParametersToTerraformString_Nil(t *testing.T) {
+	result, err := ConvertParametersToTerraformString(nil)
+	assert.NoError(t, err)
+	assert.Equal(t, types.StringValue("{}"), result)
+}
+
+func TestConvertParametersToTerraformString_Error(t *testing.T) {
+	_, err := ConvertParametersToTerraformString(map[string]interface{}{"ch": make(chan int)})
+	assert.Error(t, err)
+}
+
 func TestConvertConnectionsToTerraformMap(t *testing.T) {
 	input := map[string]interface{}{
 		"host": "localhost",
@@ -63,3 +112,26 @@ func TestConvertConnectionsToTerraformMap_Error(t *testing.T) {
 	_, err := ConvertConnectionsToTerraformMap(ch)
 	assert.Error(t, err)
 }
+
+func TestConvertRawJSONToTerraformString(t *testing.T) {
+	input := json.RawMessage(`{
+		"Start": [{"json": {"id": 1}}]
+	}`)
+
+	result, err := ConvertRawJSONToTerraformString(input)
+	assert.NoError(t, err)
+	assert.Equal(t, types.StringValue(`{"Start":[{"json":{"id":1}}]}`), result)
+}
+
+func TestConvertRawJSONToTerraformString_Null(t *testing.T) {
+	for _, input := range []json.RawMessage{nil, json.RawMessage(`null`)} {
+		result, err := ConvertRawJSONToTerraformString(input)
+		assert.NoError(t, err)
+		assert.True(t, result.IsNull())
+	}
+}
+
+func TestConvertRawJSONToTerraformString_Error(t *testing.T) {
+	_, err := ConvertRawJSONToTerraformString(json.RawMessage(`{invalid`))
+	assert.Error(t, err)
+}
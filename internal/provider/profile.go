@@ -0,0 +1,128 @@
+// Copyright (c) Arthur Diniz <arthurbdiniz@gmail.com>
+// SPDX-License-Identifier: Apache-2.0
+
+package provider
+
+import (
+	"bufio"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+)
+
+// profile holds the provider settings read from a named section of the
+// credentials file. Empty or nil fields were not set in the profile.
+type profile struct {
+	Host                  string
+	Token                 string
+	UserAgentSuffix       string
+	RequestsPerSecond     *float64
+	MaxConcurrentRequests *int64
+}
+
+// defaultCredentialsFile returns the credentials file used when none is
+// configured: ~/.config/n8n/credentials, on every platform.
+func defaultCredentialsFile() (string, error) {
+	home, err := os.UserHomeDir()
+	if err != nil {
+		return "", err
+	}
+	return filepath.Join(home, ".config", "n8n", "credentials"), nil
+}
+
+// loadProfile reads the profile called name from the credentials file at
+// filename. The file uses INI syntax, with one section per profile:
+//
+//	[prod-eu]
+//	host  = https://eu.n8n.example.com
+//	token = ...
+func loadProfile(filename, name string) (profile, error) {
+	file, err := os.Open(filename)
+	if err != nil {
+		return profile{}, err
+	}
+	defer file.Close()
+
+	var (
+		result  profile
+		section string
+		found   bool
+		line    int
+	)
+
+	scanner := bufio.NewScanner(file)
+	for scanner.Scan() {
+		line++
+		text := strings.TrimSpace(scanner.Text())
+		if text == "" || strings.HasPrefix(text, "#") || strings.HasPrefix(text, ";") {
+			continue
+		}
+
+		if strings.HasPrefix(text, "[") {
+			if !strings.HasSuffix(text, "]") {
+				return profile{}, fmt.Errorf("%s:%d: invalid section header %q", filename, line, text)
+			}
+			section = strings.TrimSpace(text[1 : len(text)-1])
+			found = found || section == name
+			continue
+		}
+
+		key, value, ok := strings.Cut(text, "=")
+		if !ok {
+			return profile{}, fmt.Errorf("%s:%d: expected key = value", filename, line)
+		}
+		if section != name {
+			continue
+		}
+
+		key = strings.TrimSpace(key)
+		if err := result.set(key, unquote(strings.TrimSpace(value))); err != nil {
+			return profile{}, fmt.Errorf("%s:%d: %w", filename, line, err)
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return profile{}, err
+	}
+
+	if !found {
+		return profile{}, fmt.Errorf("profile %q not found in %s", name, filename)
+	}
+
+	return result, nil
+}
+
+func (p *profile) set(key, value string) error {
+	switch key {
+	case "host":
+		p.Host = value
+	case "token":
+		p.Token = value
+	case "user_agent_suffix":
+		p.UserAgentSuffix = value
+	case "requests_per_second":
+		requestsPerSecond, err := strconv.ParseFloat(value, 64)
+		if err != nil || requestsPerSecond < 0 {
+			return fmt.Errorf("requests_per_second must be a number of at least 0, got %q", value)
+		}
+		p.RequestsPerSecond = &requestsPerSecond
+	case "max_concurrent_requests":
+		maxConcurrentRequests, err := strconv.ParseInt(value, 10, 64)
+		if err != nil || maxConcurrentRequests < 0 {
+			return fmt.Errorf("max_concurrent_requests must be an integer of at least 0, got %q", value)
+		}
+		p.MaxConcurrentRequests = &maxConcurrentRequests
+	default:
+		return fmt.Errorf("unknown key %q", key)
+	}
+	return nil
+}
+
+// unquote removes matching single or double quotes around a value.
+func unquote(value string) string {
+	if len(value) >= 2 && (value[0] == '"' || value[0] == '\'') && value[len(value)-1] == value[0] {
+		return value[1 : len(value)-1]
+	}
+	return value
+}
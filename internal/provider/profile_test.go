@@ -0,0 +1,220 @@
+// Copyright (c) Arthur Diniz <arthurbdiniz@gmail.com>
+// SPDX-License-Identifier: Apache-2.0
+
+package provider
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sync"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func writeCredentialsFile(t *testing.T, content string) string {
+	t.Helper()
+
+	filename := filepath.Join(t.TempDir(), "credentials")
+	require.NoError(t, os.WriteFile(filename, []byte(content), 0o600))
+	return filename
+}
+
+func TestLoadProfile(t *testing.T) {
+	filename := writeCredentialsFile(t, `
+# Shared n8n instances
+[prod-us]
+host  = https://us.n8n.example.com
+token = us-token
+
+[prod-eu]
+host                    = "https://eu.n8n.example.com"
+token                   = 'eu-token'
+user_agent_suffix       = deploy
+; throttle the smaller instance
+requests_per_second     = 2.5
+max_concurrent_requests = 3
+`)
+
+	settings, err := loadProfile(filename, "prod-eu")
+	require.NoError(t, err)
+
+	assert.Equal(t, "https://eu.n8n.example.com", settings.Host)
+	assert.Equal(t, "eu-token", settings.Token)
+	assert.Equal(t, "deploy", settings.UserAgentSuffix)
+	require.NotNil(t, settings.RequestsPerSecond)
+	assert.Equal(t, 2.5, *settings.RequestsPerSecond)
+	require.NotNil(t, settings.MaxConcurrentRequests)
+	assert.Equal(t, int64(3), *settings.MaxConcurrentRequests)
+
+	settings, err = loadProfile(filename, "prod-us")
+	require.NoError(t, err)
+
+	assert.Equal(t, profile{Host: "https://us.n8n.example.com", Token: "us-token"}, settings)
+}
+
+func TestLoadProfile_Errors(t *testing.T) {
+	tests := map[string]struct {
+		content  string
+		expected string
+	}{
+		"missing profile": {
+			content:  "[dev]\nhost = http://localhost:5678\n",
+			expected: `profile "prod" not found`,
+		},
+		"unknown key": {
+			content:  "[prod]\nhots = http://localhost:5678\n",
+			expected: `:2: unknown key "hots"`,
+		},
+		"invalid line": {
+			content:  "[prod]\nhost\n",
+			expected: `:2: expected key = value`,
+		},
+		"invalid section": {
+			content:  "[prod\n",
+			expected: `:1: invalid section header "[prod"`,
+		},
+		"invalid number": {
+			content:  "[prod]\nrequests_per_second = fast\n",
+			expected: `requests_per_second must be a number of at least 0, got "fast"`,
+		},
+		"negative integer": {
+			content:  "[prod]\nmax_concurrent_requests = -1\n",
+			expected: `max_concurrent_requests must be an integer of at least 0, got "-1"`,
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			_, err := loadProfile(writeCredentialsFile(t, tc.content), "prod")
+			require.Error(t, err)
+			assert.Contains(t, err.Error(), tc.expected)
+		})
+	}
+}
+
+func TestLoadProfile_MissingFile(t *testing.T) {
+	_, err := loadProfile(filepath.Join(t.TempDir(), "credentials"), "prod")
+	assert.ErrorIs(t, err, os.ErrNotExist)
+}
+
+func TestDefaultCredentialsFile(t *testing.T) {
+	home := t.TempDir()
+	t.Setenv("HOME", home)
+	t.Setenv("USERPROFILE", home)
+	t.Setenv("XDG_CONFIG_HOME", filepath.Join(home, "xdg"))
+
+	filename, err := defaultCredentialsFile()
+	require.NoError(t, err)
+	assert.Equal(t, filepath.Join(home, ".config", "n8n", "credentials"), filename)
+}
+
+func TestProvider_Profile(t *testing.T) {
+	var mu sync.Mutex
+	var tokens []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		defer mu.Unlock()
+		tokens = append(tokens, r.Header.Get("X-N8N-API-KEY"))
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"data": [], "nextCursor": null}`))
+	}))
+	t.Cleanup(server.Close)
+
+	filename := writeCredentialsFile(t, fmt.Sprintf("[prod-eu]\nhost = %s\ntoken = file-token\n", server.URL))
+
+	tests := map[string]struct {
+		env      map[string]string
+		provider string
+		expected string
+	}{
+		"profile from configuration": {
+			provider: fmt.Sprintf(`profile = "prod-eu"
+				credentials_file = %q`, filename),
+			expected: "file-token",
+		},
+		"profile from environment": {
+			env: map[string]string{
+				"N8N_PROFILE":          "prod-eu",
+				"N8N_CREDENTIALS_FILE": filename,
+			},
+			expected: "file-token",
+		},
+		"environment overrides profile": {
+			env: map[string]string{"N8N_TOKEN": "env-token"},
+			provider: fmt.Sprintf(`profile = "prod-eu"
+				credentials_file = %q`, filename),
+			expected: "env-token",
+		},
+		"configuration overrides environment": {
+			env: map[string]string{"N8N_TOKEN": "env-token"},
+			provider: fmt.Sprintf(`profile = "prod-eu"
+				credentials_file = %q
+				token = "config-token"`, filename),
+			expected: "config-token",
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Setenv("N8N_HOST", "")
+			t.Setenv("N8N_TOKEN", "")
+			t.Setenv("N8N_PROFILE", "")
+			t.Setenv("N8N_CREDENTIALS_FILE", "")
+			for key, value := range tc.env {
+				t.Setenv(key, value)
+			}
+
+			mu.Lock()
+			tokens = nil
+			mu.Unlock()
+
+			resource.UnitTest(t, resource.TestCase{
+				ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+				Steps: []resource.TestStep{
+					{
+						Config: fmt.Sprintf(`
+							provider "n8n" {
+								%s
+							}
+
+							data "n8n_workflows" "test" {}
+						`, tc.provider),
+					},
+				},
+			})
+
+			mu.Lock()
+			defer mu.Unlock()
+			require.NotEmpty(t, tokens)
+			assert.Equal(t, tc.expected, tokens[0])
+		})
+	}
+}
+
+func TestProvider_ProfileNotFound(t *testing.T) {
+	filename := writeCredentialsFile(t, "[dev]\nhost = http://localhost:5678\ntoken = dev-token\n")
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+					provider "n8n" {
+						profile          = "prod-eu"
+						credentials_file = %q
+					}
+
+					data "n8n_workflows" "test" {}
+				`, filename),
+				ExpectError: regexp.MustCompile(`Unable to Read n8n Profile`),
+			},
+		},
+	})
+}
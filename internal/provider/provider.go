@@ -5,22 +5,35 @@ package provider
 
 import (
 	"context"
+	"errors"
+	"fmt"
+	"math"
+	"net/http"
 	"os"
+	"strings"
 
 	"github.com/arthurbdiniz/terraform-provider-n8n/internal/pkg/n8n-client-go"
+	"github.com/hashicorp/terraform-plugin-framework-validators/float64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
 	"github.com/hashicorp/terraform-plugin-framework/function"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/provider"
 	"github.com/hashicorp/terraform-plugin-framework/provider/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 )
 
 // Ensure the implementation satisfies the expected interfaces.
 var (
-	_ provider.Provider = &n8nProvider{}
+	_ provider.Provider                       = &n8nProvider{}
+	_ provider.ProviderWithFunctions          = &n8nProvider{}
+	_ provider.ProviderWithEphemeralResources = &n8nProvider{}
 )
 
 // New is a helper function to simplify provider server and testing implementation.
@@ -32,10 +45,29 @@ func New(version string) func() provider.Provider {
 	}
 }
 
+// NewWithClient returns a provider that uses the given client instead of
+// building one from the host and token configuration. It allows tests to
+// point the provider at a local stand-in for the n8n API.
+func NewWithClient(version string, client *n8n.Client) func() provider.Provider {
+	return func() provider.Provider {
+		return &n8nProvider{
+			version: version,
+			client:  client,
+		}
+	}
+}
+
 // n8nProviderModel maps provider schema data to a Go type.
 type n8nProviderModel struct {
-	Host  types.String `tfsdk:"host"`
-	Token types.String `tfsdk:"token"`
+	Host                  types.String  `tfsdk:"host"`
+	Token                 types.String  `tfsdk:"token"`
+	UserAgentSuffix       types.String  `tfsdk:"user_agent_suffix"`
+	RequestsPerSecond     types.Float64 `tfsdk:"requests_per_second"`
+	MaxConcurrentRequests types.Int64   `tfsdk:"max_concurrent_requests"`
+	Profile               types.String  `tfsdk:"profile"`
+	CredentialsFile       types.String  `tfsdk:"credentials_file"`
+	VerifyConnection      types.Bool    `tfsdk:"verify_connection"`
+	TokenFile             types.String  `tfsdk:"token_file"`
 }
 
 // n8nProvider is the provider implementation.
@@ -44,6 +76,10 @@ type n8nProvider struct {
 	// provider is built and ran locally, and "test" when running acceptance
 	// testing.
 	version string
+
+	// client, when set, is used as is and the host and token configuration
+	// is ignored.
+	client *n8n.Client
 }
 
 // Metadata returns the provider type name.
@@ -58,14 +94,62 @@ func (p *n8nProvider) Schema(_ context.Context, _ provider.SchemaRequest, resp *
 		Description: "Interact with n8n.",
 		Attributes: map[string]schema.Attribute{
 			"host": schema.StringAttribute{
-				Description: "URI for n8n API. May also be provided via `N8N_HOST` environment variable.",
-				Optional:    true,
+				Description: "Base URL of the n8n instance, including the path prefix when n8n is served under a subpath, " +
+					"for example `https://example.com/n8n`. A trailing `/api/v1` is ignored. " +
+					"May also be provided via `N8N_HOST` environment variable.",
+				Optional: true,
 			},
 			"token": schema.StringAttribute{
 				Description: "Token for n8n API. May also be provided via `N8N_TOKEN` environment variable.",
 				Optional:    true,
 				Sensitive:   true,
 			},
+			"token_file": schema.StringAttribute{
+				Description: "Path of a file holding the token for n8n API, read every time the provider is configured, " +
+					"so a token rotated by another tool is picked up without changing the configuration. Conflicts with `token`. " +
+					"May also be provided via `N8N_TOKEN_FILE` environment variable.",
+				Optional: true,
+				Validators: []validator.String{
+					stringvalidator.ConflictsWith(path.MatchRoot("token")),
+				},
+			},
+			"user_agent_suffix": schema.StringAttribute{
+				Description: "Text appended to the User-Agent header sent to n8n, for example to identify the pipeline making a change. " +
+					"May also be provided via `N8N_USER_AGENT_SUFFIX` environment variable.",
+				Optional: true,
+			},
+			"requests_per_second": schema.Float64Attribute{
+				Description: "Maximum number of requests per second sent to n8n by all data sources and resources of this provider. " +
+					"Defaults to `0`, which does not limit the request rate.",
+				Optional: true,
+				Validators: []validator.Float64{
+					float64validator.AtLeast(0),
+				},
+			},
+			"max_concurrent_requests": schema.Int64Attribute{
+				Description: "Maximum number of requests in flight to n8n at once, regardless of Terraform parallelism. " +
+					"Defaults to `0`, which does not limit concurrency.",
+				Optional: true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(0),
+				},
+			},
+			"profile": schema.StringAttribute{
+				Description: "Name of a profile in the credentials file to read `host`, `token` and the other provider settings from. " +
+					"Values set in the configuration or the environment take precedence over the profile. " +
+					"May also be provided via `N8N_PROFILE` environment variable.",
+				Optional: true,
+			},
+			"credentials_file": schema.StringAttribute{
+				Description: "Path of the credentials file holding the profiles. Defaults to `~/.config/n8n/credentials`. " +
+					"May also be provided via `N8N_CREDENTIALS_FILE` environment variable.",
+				Optional: true,
+			},
+			"verify_connection": schema.BoolAttribute{
+				Description: "Make an authenticated request to n8n when the provider is configured, " +
+					"so an unreachable host or an invalid token is reported before any data source or resource is read. Defaults to `false`.",
+				Optional: true,
+			},
 		},
 	}
 }
@@ -73,6 +157,12 @@ func (p *n8nProvider) Schema(_ context.Context, _ provider.SchemaRequest, resp *
 func (p *n8nProvider) Configure(ctx context.Context, req provider.ConfigureRequest, resp *provider.ConfigureResponse) {
 	tflog.Info(ctx, "Configuring n8n client")
 
+	if p.client != nil {
+		resp.DataSourceData = p.client
+		resp.ResourceData = p.client
+		return
+	}
+
 	// Retrieve provider data from configuration
 	var config n8nProviderModel
 	diags := req.Config.Get(ctx, &config)
@@ -101,21 +191,122 @@ func (p *n8nProvider) Configure(ctx context.Context, req provider.ConfigureReque
 		)
 	}
 
+	if config.TokenFile.IsUnknown() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("token_file"),
+			"Unknown n8n API Token File",
+			"The provider cannot create the n8n API client as there is an unknown configuration value for the n8n API token file. "+
+				"Either target apply the source of the value first, set the value statically in the configuration, or use the N8N_TOKEN_FILE environment variable.",
+		)
+	}
+
+	if config.Profile.IsUnknown() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("profile"),
+			"Unknown n8n Profile",
+			"The provider cannot create the n8n API client as there is an unknown configuration value for the n8n profile. "+
+				"Either target apply the source of the value first, set the value statically in the configuration, or use the N8N_PROFILE environment variable.",
+		)
+	}
+
+	if config.CredentialsFile.IsUnknown() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("credentials_file"),
+			"Unknown n8n Credentials File",
+			"The provider cannot create the n8n API client as there is an unknown configuration value for the n8n credentials file. "+
+				"Either target apply the source of the value first, set the value statically in the configuration, or use the N8N_CREDENTIALS_FILE environment variable.",
+		)
+	}
+
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
-	// Default values to environment variables, but override
-	// with Terraform configuration value if set.
-	host := os.Getenv("N8N_HOST")
-	token := os.Getenv("N8N_TOKEN")
+	// Start from the profile, if any, then override with environment
+	// variables and finally with Terraform configuration values if set.
+	settings := readProfile(config, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	host := settings.Host
+	token := settings.Token
+	userAgentSuffix := settings.UserAgentSuffix
+	requestsPerSecond := 0.0
+	maxConcurrentRequests := int64(0)
+
+	if settings.RequestsPerSecond != nil {
+		requestsPerSecond = *settings.RequestsPerSecond
+	}
+
+	if settings.MaxConcurrentRequests != nil {
+		maxConcurrentRequests = *settings.MaxConcurrentRequests
+	}
+
+	if v := os.Getenv("N8N_HOST"); v != "" {
+		host = v
+	}
+
+	// The token file is only read once the token precedence is resolved, so
+	// an unreadable file overridden by a token does not fail the provider.
+	tokenFile := ""
+
+	if v := os.Getenv("N8N_TOKEN_FILE"); v != "" {
+		token, tokenFile = "", v
+	}
+
+	if v := os.Getenv("N8N_TOKEN"); v != "" {
+		token, tokenFile = v, ""
+	}
+
+	if v := os.Getenv("N8N_USER_AGENT_SUFFIX"); v != "" {
+		userAgentSuffix = v
+	}
 
 	if !config.Host.IsNull() {
 		host = config.Host.ValueString()
 	}
 
+	if !config.TokenFile.IsNull() {
+		token, tokenFile = "", config.TokenFile.ValueString()
+	}
+
 	if !config.Token.IsNull() {
-		token = config.Token.ValueString()
+		token, tokenFile = config.Token.ValueString(), ""
+	}
+
+	if tokenFile != "" {
+		token = readTokenFile(tokenFile, &resp.Diagnostics)
+	}
+
+	if !config.UserAgentSuffix.IsNull() && !config.UserAgentSuffix.IsUnknown() {
+		userAgentSuffix = config.UserAgentSuffix.ValueString()
+	}
+
+	if !config.RequestsPerSecond.IsNull() && !config.RequestsPerSecond.IsUnknown() {
+		requestsPerSecond = config.RequestsPerSecond.ValueFloat64()
+	}
+
+	if !config.MaxConcurrentRequests.IsNull() && !config.MaxConcurrentRequests.IsUnknown() {
+		maxConcurrentRequests = config.MaxConcurrentRequests.ValueInt64()
+	}
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// A provider block without any host or token, typically an alias used
+	// only for the n8n_api_token ephemeral resource, gets no client. It is
+	// reported as a warning, as a misconfigured provider would otherwise only
+	// fail once a data source or resource reaches checkClient.
+	if host == "" && token == "" {
+		resp.Diagnostics.AddWarning(
+			"Missing n8n API Host and Token",
+			"The provider has no value for the n8n API host and token, so only ephemeral resources are available. "+
+				"Set the host and token values in the configuration, use the N8N_HOST and N8N_TOKEN environment variables, or select a profile. "+
+				"This warning can be ignored for a provider only used by ephemeral resources.",
+		)
+		return
 	}
 
 	// If any of the expected configurations are missing, return
@@ -125,7 +316,7 @@ func (p *n8nProvider) Configure(ctx context.Context, req provider.ConfigureReque
 			path.Root("host"),
 			"Missing n8n API Host",
 			"The provider cannot create the n8n API client as there is a missing or empty value for the n8n API host. "+
-				"Set the host value in the configuration or use the N8N_HOST environment variable. "+
+				"Set the host value in the configuration, use the N8N_HOST environment variable, or set it in the selected profile. "+
 				"If either is already set, ensure the value is not empty.",
 		)
 	}
@@ -135,7 +326,7 @@ func (p *n8nProvider) Configure(ctx context.Context, req provider.ConfigureReque
 			path.Root("token"),
 			"Missing n8n API Token",
 			"The provider cannot create the n8n API client as there is a missing or empty value for the n8n API token. "+
-				"Set the token value in the configuration or use the N8N_TOKEN environment variable. "+
+				"Set the token value in the configuration, use the N8N_TOKEN environment variable, or set it in the selected profile. "+
 				"If either is already set, ensure the value is not empty.",
 		)
 	}
@@ -144,6 +335,19 @@ func (p *n8nProvider) Configure(ctx context.Context, req provider.ConfigureReque
 		return
 	}
 
+	normalizedHost, err := n8n.NormalizeHostURL(host)
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("host"),
+			"Invalid n8n API Host",
+			"The provider cannot create the n8n API client as the n8n API host is not a valid URL. "+
+				"Set it to the base URL of the n8n instance, for example https://n8n.example.com.\n\n"+
+				"Error: "+err.Error(),
+		)
+		return
+	}
+	host = normalizedHost
+
 	ctx = tflog.SetField(ctx, "n8n_host", host)
 	ctx = tflog.SetField(ctx, "n8n_token", token)
 	ctx = tflog.MaskFieldValuesWithFieldKeys(ctx, "n8n_token")
@@ -151,7 +355,14 @@ func (p *n8nProvider) Configure(ctx context.Context, req provider.ConfigureReque
 	tflog.Debug(ctx, "Creating n8n client")
 
 	// Create a new n8n client using the configuration values
-	client, err := n8n.NewClient(&host, &token)
+	// The client is shared by every data source and resource, so the limits
+	// apply to the provider as a whole.
+	client, err := n8n.NewClient(&host, &token,
+		n8n.WithUserAgent(userAgent(p.version, req.TerraformVersion, userAgentSuffix)),
+		n8n.WithLogger(newClientLogger(token)),
+		n8n.WithRateLimit(requestsPerSecond, int(math.Ceil(requestsPerSecond))),
+		n8n.WithMaxConcurrentRequests(int(maxConcurrentRequests)),
+	)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Unable to Create n8n API Client",
@@ -162,6 +373,14 @@ func (p *n8nProvider) Configure(ctx context.Context, req provider.ConfigureReque
 		return
 	}
 
+	if config.VerifyConnection.ValueBool() {
+		tflog.Debug(ctx, "Verifying n8n connection")
+		verifyConnection(client.WithContext(ctx), &resp.Diagnostics)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
 	// Make the n8n client available during DataSource and Resource
 	// type Configure methods.
 	resp.DataSourceData = client
@@ -170,6 +389,139 @@ func (p *n8nProvider) Configure(ctx context.Context, req provider.ConfigureReque
 	tflog.Info(ctx, "Configured n8n client", map[string]any{"success": true})
 }
 
+// readTokenFile returns the token stored in filename, without surrounding
+// whitespace.
+func readTokenFile(filename string, diags *diag.Diagnostics) string {
+	token, err := readTokenFromFile(filename)
+	if err != nil {
+		diags.AddAttributeError(
+			path.Root("token_file"),
+			"Unable to Read n8n API Token File",
+			"The provider cannot read the n8n API token from the token file.\n\n"+
+				"Error: "+err.Error(),
+		)
+	}
+
+	return token
+}
+
+// checkClient reports an error when the provider was configured without a
+// host and token, which only allows the use of ephemeral resources.
+func checkClient(client *n8n.Client, diags *diag.Diagnostics) bool {
+	if client != nil {
+		return true
+	}
+
+	diags.AddError(
+		"Unconfigured n8n Provider",
+		"The n8n provider has no host or token configured, so it can only be used for ephemeral resources. "+
+			"Set host and token in the provider configuration, use the N8N_HOST and N8N_TOKEN environment variables, or select a profile.",
+	)
+	return false
+}
+
+// verifyConnection makes a cheap authenticated request and reports an invalid
+// token or an unreachable host as a diagnostic.
+func verifyConnection(client *n8n.Client, diags *diag.Diagnostics) {
+	err := client.VerifyConnection()
+	if err == nil {
+		return
+	}
+
+	var apiErr *n8n.APIError
+	switch {
+	case errors.As(err, &apiErr) && (apiErr.StatusCode == http.StatusUnauthorized || apiErr.StatusCode == http.StatusForbidden):
+		diags.AddAttributeError(
+			path.Root("token"),
+			"Invalid n8n API Token",
+			"n8n rejected the API token. Check that the token is correct and has not expired or been revoked.\n\n"+
+				"n8n Client Error: "+err.Error(),
+		)
+	case errors.As(err, &apiErr) && apiErr.StatusCode == http.StatusNotFound:
+		diags.AddAttributeError(
+			path.Root("host"),
+			"n8n API Not Found",
+			fmt.Sprintf("No n8n API was found at %s/api/v1. ", client.HostURL)+
+				"Check the host URL, including the path prefix when n8n is served under a subpath, and that the public API is enabled.\n\n"+
+				"n8n Client Error: "+err.Error(),
+		)
+	case errors.As(err, &apiErr):
+		diags.AddError(
+			"Unable to Verify n8n Connection",
+			"n8n returned an unexpected response while verifying the connection.\n\n"+
+				"n8n Client Error: "+err.Error(),
+		)
+	default:
+		diags.AddAttributeError(
+			path.Root("host"),
+			"Unable to Reach n8n",
+			fmt.Sprintf("The provider cannot connect to n8n at %s. ", client.HostURL)+
+				"Check the host URL and that the n8n instance is running and reachable.\n\n"+
+				"Error: "+err.Error(),
+		)
+	}
+}
+
+// readProfile returns the settings of the profile selected in the
+// configuration or with N8N_PROFILE, or an empty profile when none is
+// selected.
+func readProfile(config n8nProviderModel, diags *diag.Diagnostics) profile {
+	name := os.Getenv("N8N_PROFILE")
+	if !config.Profile.IsNull() {
+		name = config.Profile.ValueString()
+	}
+
+	if name == "" {
+		return profile{}
+	}
+
+	filename := os.Getenv("N8N_CREDENTIALS_FILE")
+	if !config.CredentialsFile.IsNull() {
+		filename = config.CredentialsFile.ValueString()
+	}
+
+	if filename == "" {
+		var err error
+		filename, err = defaultCredentialsFile()
+		if err != nil {
+			diags.AddAttributeError(
+				path.Root("credentials_file"),
+				"Unable to Locate n8n Credentials File",
+				"The provider cannot determine the default location of the n8n credentials file. "+
+					"Set the credentials_file value in the configuration or use the N8N_CREDENTIALS_FILE environment variable.\n\n"+
+					"Error: "+err.Error(),
+			)
+			return profile{}
+		}
+	}
+
+	settings, err := loadProfile(filename, name)
+	if err != nil {
+		diags.AddAttributeError(
+			path.Root("profile"),
+			"Unable to Read n8n Profile",
+			fmt.Sprintf("The provider cannot read the n8n profile %q from the credentials file %s.\n\n", name, filename)+
+				"Error: "+err.Error(),
+		)
+		return profile{}
+	}
+
+	return settings
+}
+
+// userAgent builds the User-Agent sent to n8n, identifying the provider and
+// Terraform versions, followed by the optional user-supplied suffix.
+func userAgent(providerVersion, terraformVersion, suffix string) string {
+	userAgent := "terraform-provider-n8n/" + providerVersion
+	if terraformVersion != "" {
+		userAgent += " (terraform " + terraformVersion + ")"
+	}
+	if suffix = strings.TrimSpace(suffix); suffix != "" {
+		userAgent += " " + suffix
+	}
+	return userAgent
+}
+
 // DataSources defines the data sources implemented in the provider.
 func (p *n8nProvider) DataSources(_ context.Context) []func() datasource.DataSource {
 	return []func() datasource.DataSource{
@@ -180,10 +532,27 @@ func (p *n8nProvider) DataSources(_ context.Context) []func() datasource.DataSou
 
 // Resources defines the resources implemented in the provider.
 func (p *n8nProvider) Resources(_ context.Context) []func() resource.Resource {
-	return []func() resource.Resource{}
+	return []func() resource.Resource{
+		NewWorkflowResource,
+		NewWorkflowSettingsResource,
+		NewErrorWorkflowBindingResource,
+		NewWorkflowCopyResource,
+	}
+}
+
+// EphemeralResources defines the ephemeral resources implemented in the provider.
+func (p *n8nProvider) EphemeralResources(_ context.Context) []func() ephemeral.EphemeralResource {
+	return []func() ephemeral.EphemeralResource{
+		NewAPITokenEphemeralResource,
+	}
 }
 
 // Functions defines the functions implemented in the provider.
 func (p *n8nProvider) Functions(_ context.Context) []func() function.Function {
-	return []func() function.Function{}
+	return []func() function.Function{
+		NewWorkflowDecodeFunction,
+		NewWorkflowEncodeFunction,
+		NewWorkflowNodeFunction,
+		NewWorkflowRemapSubWorkflowsFunction,
+	}
 }
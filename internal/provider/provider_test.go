@@ -4,11 +4,27 @@
 package provider
 
 import (
+	"context"
 	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sync"
+	"testing"
+	"time"
 
 	"github.com/arthurbdiniz/terraform-provider-n8n/internal/config"
+	"github.com/arthurbdiniz/terraform-provider-n8n/internal/pkg/n8n-client-go"
+	"github.com/arthurbdiniz/terraform-provider-n8n/internal/pkg/n8n-client-go/n8ntest"
+	"github.com/hashicorp/terraform-plugin-framework/provider"
 	"github.com/hashicorp/terraform-plugin-framework/providerserver"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
 	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/stretchr/testify/require"
 )
 
 var (
@@ -35,3 +51,454 @@ provider "n8n" {
 }
 `, url, config.ApiToken)
 }
+
+// newTestServer starts an in-memory fake of the n8n API that accepts the token
+// used by GetProviderConfig, so acceptance tests can run without Docker.
+func newTestServer(t *testing.T) *n8ntest.Server {
+	return n8ntest.NewServer(t, config.ApiToken)
+}
+
+// testProtoV6ProviderFactoriesForURL returns provider factories whose provider
+// talks to the n8n API at url through an injected client, so test
+// configurations do not need a provider block.
+func testProtoV6ProviderFactoriesForURL(t *testing.T, url string) map[string]func() (tfprotov6.ProviderServer, error) {
+	client, err := n8n.NewClient(&url, &config.ApiToken)
+	require.NoError(t, err)
+
+	return map[string]func() (tfprotov6.ProviderServer, error){
+		"n8n": providerserver.NewProtocol6WithError(NewWithClient("test", client)()),
+	}
+}
+
+// newStandInServer starts a server that answers every request with the given
+// status and body, for testing how the provider handles malformed responses.
+func newStandInServer(t *testing.T, status int, body string) *httptest.Server {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(status)
+		_, _ = w.Write([]byte(body))
+	}))
+	t.Cleanup(server.Close)
+
+	return server
+}
+
+func TestUserAgent(t *testing.T) {
+	tests := map[string]struct {
+		providerVersion  string
+		terraformVersion string
+		suffix           string
+		expected         string
+	}{
+		"versions": {
+			providerVersion:  "1.2.3",
+			terraformVersion: "1.9.0",
+			expected:         "terraform-provider-n8n/1.2.3 (terraform 1.9.0)",
+		},
+		"suffix": {
+			providerVersion:  "1.2.3",
+			terraformVersion: "1.9.0",
+			suffix:           " pipeline/deploy-42 ",
+			expected:         "terraform-provider-n8n/1.2.3 (terraform 1.9.0) pipeline/deploy-42",
+		},
+		"unknown terraform version": {
+			providerVersion: "dev",
+			expected:        "terraform-provider-n8n/dev",
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			require.Equal(t, tc.expected, userAgent(tc.providerVersion, tc.terraformVersion, tc.suffix))
+		})
+	}
+}
+
+func TestProvider_UserAgentHeader(t *testing.T) {
+	var mu sync.Mutex
+	var userAgents []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		defer mu.Unlock()
+		userAgents = append(userAgents, r.Header.Get("User-Agent"))
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"data": [], "nextCursor": null}`))
+	}))
+	t.Cleanup(server.Close)
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+					provider "n8n" {
+						host              = "%s"
+						token             = "test-token"
+						user_agent_suffix = "pipeline/deploy-42"
+					}
+
+					data "n8n_workflows" "test" {}
+				`, server.URL),
+			},
+		},
+	})
+
+	mu.Lock()
+	defer mu.Unlock()
+	require.NotEmpty(t, userAgents)
+	require.Regexp(t, `^terraform-provider-n8n/test \(terraform \d+\.\d+\.\d+\S*\) pipeline/deploy-42$`, userAgents[0])
+}
+
+func TestProvider_MaxConcurrentRequests(t *testing.T) {
+	var mu sync.Mutex
+	var inFlight, maxInFlight, requests int
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		inFlight++
+		requests++
+		maxInFlight = max(maxInFlight, inFlight)
+		mu.Unlock()
+
+		time.Sleep(10 * time.Millisecond)
+
+		mu.Lock()
+		inFlight--
+		mu.Unlock()
+
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"data": [], "nextCursor": null}`))
+	}))
+	t.Cleanup(server.Close)
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+					provider "n8n" {
+						host                    = "%s"
+						token                   = "test-token"
+						requests_per_second     = 100
+						max_concurrent_requests = 1
+					}
+
+					data "n8n_workflows" "a" {}
+					data "n8n_workflows" "b" {}
+					data "n8n_workflows" "c" {}
+					data "n8n_workflows" "d" {}
+				`, server.URL),
+			},
+		},
+	})
+
+	mu.Lock()
+	defer mu.Unlock()
+	require.GreaterOrEqual(t, requests, 4)
+	require.Equal(t, 1, maxInFlight)
+}
+
+func TestProvider_InvalidThrottleConfig(t *testing.T) {
+	for name, attribute := range map[string]string{
+		"requests_per_second":     "requests_per_second = -1",
+		"max_concurrent_requests": "max_concurrent_requests = -1",
+	} {
+		t.Run(name, func(t *testing.T) {
+			resource.UnitTest(t, resource.TestCase{
+				ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+				Steps: []resource.TestStep{
+					{
+						Config: fmt.Sprintf(`
+							provider "n8n" {
+								host  = "http://localhost:5678"
+								token = "test-token"
+								%s
+							}
+
+							data "n8n_workflows" "test" {}
+						`, attribute),
+						ExpectError: regexp.MustCompile(`must be at least 0`),
+					},
+				},
+			})
+		})
+	}
+}
+
+func TestProvider_InvalidHost(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+					provider "n8n" {
+						host  = "localhost:5678"
+						token = "test-token"
+					}
+
+					data "n8n_workflows" "test" {}
+				`,
+				ExpectError: regexp.MustCompile(`(?s)Invalid n8n API Host.*must start with http:// or https://`),
+			},
+		},
+	})
+}
+
+func TestProvider_HostSubpath(t *testing.T) {
+	var mu sync.Mutex
+	var paths []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		defer mu.Unlock()
+		paths = append(paths, r.URL.Path)
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"data": [], "nextCursor": null}`))
+	}))
+	t.Cleanup(server.Close)
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+					provider "n8n" {
+						host              = "%s/n8n/api/v1/"
+						token             = "test-token"
+						verify_connection = true
+					}
+
+					data "n8n_workflows" "test" {}
+				`, server.URL),
+			},
+		},
+	})
+
+	mu.Lock()
+	defer mu.Unlock()
+	require.NotEmpty(t, paths)
+	for _, p := range paths {
+		require.Equal(t, "/n8n/api/v1/workflows", p)
+	}
+}
+
+func TestProvider_VerifyConnection(t *testing.T) {
+	unreachable := httptest.NewServer(http.NotFoundHandler())
+	unreachable.Close()
+
+	tests := map[string]struct {
+		host     string
+		expected string
+	}{
+		"invalid token": {
+			host:     newStandInServer(t, http.StatusUnauthorized, `{"message":"unauthorized"}`).URL,
+			expected: `(?s)Invalid n8n API Token.*status: 401`,
+		},
+		"wrong path": {
+			host:     newStandInServer(t, http.StatusNotFound, `Cannot GET /api/v1/workflows`).URL,
+			expected: `(?s)n8n API Not Found.*path prefix`,
+		},
+		"server error": {
+			host:     newStandInServer(t, http.StatusInternalServerError, `{"message":"boom"}`).URL,
+			expected: `(?s)Unable to Verify n8n Connection.*status: 500`,
+		},
+		"unreachable host": {
+			host:     unreachable.URL,
+			expected: `Unable to Reach n8n`,
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			resource.UnitTest(t, resource.TestCase{
+				ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+				Steps: []resource.TestStep{
+					{
+						Config: fmt.Sprintf(`
+							provider "n8n" {
+								host              = %q
+								token             = "test-token"
+								verify_connection = true
+							}
+
+							data "n8n_workflows" "test" {}
+						`, tc.host),
+						ExpectError: regexp.MustCompile(tc.expected),
+					},
+				},
+			})
+		})
+	}
+}
+
+func TestProvider_TokenFile(t *testing.T) {
+	var mu sync.Mutex
+	var tokens []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		defer mu.Unlock()
+		tokens = append(tokens, r.Header.Get("X-N8N-API-KEY"))
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"data": [], "nextCursor": null}`))
+	}))
+	t.Cleanup(server.Close)
+
+	dir := t.TempDir()
+	configFile := filepath.Join(dir, "config-token")
+	envFile := filepath.Join(dir, "env-token")
+	require.NoError(t, os.WriteFile(configFile, []byte("config-file-token\n"), 0o600))
+	require.NoError(t, os.WriteFile(envFile, []byte("env-file-token\n"), 0o600))
+
+	tests := map[string]struct {
+		env      map[string]string
+		provider string
+		expected string
+	}{
+		"token file from configuration": {
+			provider: fmt.Sprintf("token_file = %q", configFile),
+			expected: "config-file-token",
+		},
+		"token file from environment": {
+			env:      map[string]string{"N8N_TOKEN_FILE": envFile},
+			expected: "env-file-token",
+		},
+		"token overrides token file from environment": {
+			env:      map[string]string{"N8N_TOKEN_FILE": envFile, "N8N_TOKEN": "env-token"},
+			expected: "env-token",
+		},
+		"configuration overrides environment": {
+			env:      map[string]string{"N8N_TOKEN": "env-token"},
+			provider: fmt.Sprintf("token_file = %q", configFile),
+			expected: "config-file-token",
+		},
+		"overridden token file is not read": {
+			env:      map[string]string{"N8N_TOKEN_FILE": filepath.Join(dir, "missing")},
+			provider: `token = "config-token"`,
+			expected: "config-token",
+		},
+		"token file overridden by environment is not read": {
+			env:      map[string]string{"N8N_TOKEN_FILE": filepath.Join(dir, "missing"), "N8N_TOKEN": "env-token"},
+			expected: "env-token",
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Setenv("N8N_TOKEN", "")
+			t.Setenv("N8N_TOKEN_FILE", "")
+			for key, value := range tc.env {
+				t.Setenv(key, value)
+			}
+
+			mu.Lock()
+			tokens = nil
+			mu.Unlock()
+
+			resource.UnitTest(t, resource.TestCase{
+				ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+				Steps: []resource.TestStep{
+					{
+						Config: fmt.Sprintf(`
+							provider "n8n" {
+								host = %q
+								%s
+							}
+
+							data "n8n_workflows" "test" {}
+						`, server.URL, tc.provider),
+					},
+				},
+			})
+
+			mu.Lock()
+			defer mu.Unlock()
+			require.NotEmpty(t, tokens)
+			require.Equal(t, tc.expected, tokens[0])
+		})
+	}
+}
+
+func TestProvider_TokenFileErrors(t *testing.T) {
+	tests := map[string]struct {
+		provider string
+		expected string
+	}{
+		"missing file": {
+			provider: fmt.Sprintf("token_file = %q", filepath.Join(t.TempDir(), "missing")),
+			expected: `Unable to Read n8n API Token File`,
+		},
+		"conflicts with token": {
+			provider: `token_file = "/tmp/token"
+								token      = "test-token"`,
+			expected: `Attribute "token" cannot be specified when "token_file" is specified`,
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			resource.UnitTest(t, resource.TestCase{
+				ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+				Steps: []resource.TestStep{
+					{
+						Config: fmt.Sprintf(`
+							provider "n8n" {
+								host = "http://localhost:5678"
+								%s
+							}
+
+							data "n8n_workflows" "test" {}
+						`, tc.provider),
+						ExpectError: regexp.MustCompile(tc.expected),
+					},
+				},
+			})
+		})
+	}
+}
+
+func TestProvider_Unconfigured(t *testing.T) {
+	t.Setenv("N8N_HOST", "")
+	t.Setenv("N8N_TOKEN", "")
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+					provider "n8n" {}
+
+					data "n8n_workflows" "test" {}
+				`,
+				ExpectError: regexp.MustCompile(`Unconfigured n8n Provider`),
+			},
+		},
+	})
+}
+
+func TestProvider_UnconfiguredWarning(t *testing.T) {
+	t.Setenv("N8N_HOST", "")
+	t.Setenv("N8N_TOKEN", "")
+	t.Setenv("N8N_TOKEN_FILE", "")
+	t.Setenv("N8N_PROFILE", "")
+
+	ctx := context.Background()
+	p := New("test")()
+
+	var schemaResp provider.SchemaResponse
+	p.Schema(ctx, provider.SchemaRequest{}, &schemaResp)
+	require.False(t, schemaResp.Diagnostics.HasError())
+
+	// An empty provider block sets every attribute to null.
+	objectType := schemaResp.Schema.Type().TerraformType(ctx).(tftypes.Object)
+	attributes := make(map[string]tftypes.Value, len(objectType.AttributeTypes))
+	for name, attributeType := range objectType.AttributeTypes {
+		attributes[name] = tftypes.NewValue(attributeType, nil)
+	}
+
+	req := provider.ConfigureRequest{Config: tfsdk.Config{Schema: schemaResp.Schema, Raw: tftypes.NewValue(objectType, attributes)}}
+	var resp provider.ConfigureResponse
+	p.Configure(ctx, req, &resp)
+
+	require.False(t, resp.Diagnostics.HasError(), resp.Diagnostics)
+	require.Len(t, resp.Diagnostics.Warnings(), 1)
+	require.Equal(t, "Missing n8n API Host and Token", resp.Diagnostics.Warnings()[0].Summary())
+	require.Nil(t, resp.ResourceData, "an unconfigured provider has no client")
+}
@@ -33,10 +33,14 @@ func workflowsNodeAttr() schema.ListNestedAttribute {
 				"position": schema.ListAttribute{
 					Description: "Position of the node in the workflow.",
 					Computed:    true,
-					ElementType: types.Int64Type,
+					ElementType: types.Float64Type,
+				},
+				"parameters_json": schema.StringAttribute{
+					Description: "JSON-encoded parameters of the node, preserving nested objects and arrays. Use `jsondecode()` to access individual values.",
+					Computed:    true,
 				},
 				"parameters": schema.ListNestedAttribute{
-					Description: "Parameters of the node.",
+					Description: "Parameters of the node, flattened to key/type/value strings. Nested objects and arrays have the type `unknown`; prefer `parameters_json` to read them.",
 					Computed:    true,
 					NestedObject: schema.NestedAttributeObject{
 						Attributes: map[string]schema.Attribute{
@@ -127,3 +131,36 @@ func workflowsTagsAttr() schema.ListNestedAttribute {
 		},
 	}
 }
+
+func workflowsWebhooksAttr() schema.ListNestedAttribute {
+	return schema.ListNestedAttribute{
+		Description: "Webhooks registered by the enabled Webhook and Form Trigger nodes of the workflow, one per node and HTTP method. " +
+			"The URLs use the provider host and the default n8n endpoints, `webhook` and `form`; " +
+			"they differ when n8n is configured with another `WEBHOOK_URL` or endpoint path.",
+		Computed: true,
+		NestedObject: schema.NestedAttributeObject{
+			Attributes: map[string]schema.Attribute{
+				"node": schema.StringAttribute{
+					Description: "Name of the node registering the webhook.",
+					Computed:    true,
+				},
+				"method": schema.StringAttribute{
+					Description: "HTTP method accepted by the webhook.",
+					Computed:    true,
+				},
+				"path": schema.StringAttribute{
+					Description: "Path of the webhook below the webhook endpoint.",
+					Computed:    true,
+				},
+				"production_url": schema.StringAttribute{
+					Description: "URL called to run the workflow once it is active.",
+					Computed:    true,
+				},
+				"test_url": schema.StringAttribute{
+					Description: "URL called to run the workflow while listening for a test event in the editor.",
+					Computed:    true,
+				},
+			},
+		},
+	}
+}
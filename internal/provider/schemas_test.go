@@ -23,6 +23,7 @@ func TestWorkflowsNodeAttr(t *testing.T) {
 	assert.Contains(t, attributes, "type_version")
 	assert.Contains(t, attributes, "position")
 	assert.Contains(t, attributes, "parameters")
+	assert.Contains(t, attributes, "parameters_json")
 
 	paramAttr, ok := attributes["parameters"].(schema.ListNestedAttribute)
 	assert.True(t, ok)
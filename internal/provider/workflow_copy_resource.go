@@ -0,0 +1,442 @@
+// Copyright (c) Arthur Diniz <arthurbdiniz@gmail.com>
+// SPDX-License-Identifier: Apache-2.0
+
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/arthurbdiniz/terraform-provider-n8n/internal/pkg/n8n-client-go"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource               = &workflowCopyResource{}
+	_ resource.ResourceWithConfigure  = &workflowCopyResource{}
+	_ resource.ResourceWithModifyPlan = &workflowCopyResource{}
+)
+
+// NewWorkflowCopyResource is a helper function to simplify the provider implementation.
+func NewWorkflowCopyResource() resource.Resource {
+	return &workflowCopyResource{}
+}
+
+// workflowCopyResource is the resource implementation.
+type workflowCopyResource struct {
+	client *n8n.Client
+}
+
+// workflowCopyResourceModel maps the resource schema data.
+type workflowCopyResourceModel struct {
+	ID                 types.String      `tfsdk:"id"`
+	SourceWorkflowJSON WorkflowJSONValue `tfsdk:"source_workflow_json"`
+	Name               types.String      `tfsdk:"name"`
+	Active             types.Bool        `tfsdk:"active"`
+	CredentialIDs      types.Map         `tfsdk:"credential_ids"`
+	WorkflowIDs        types.Map         `tfsdk:"workflow_ids"`
+	WorkflowJSON       types.String      `tfsdk:"workflow_json"`
+	VersionId          types.String      `tfsdk:"version_id"`
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *workflowCopyResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Add a nil check when handling ProviderData because Terraform
+	// sets that data after it calls the ConfigureProvider RPC.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*n8n.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *n8n.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+// Metadata returns the resource type name.
+func (r *workflowCopyResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_workflow_copy"
+}
+
+// Schema defines the schema for the resource.
+func (r *workflowCopyResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Copies a workflow from another n8n instance, for example to promote it from staging to production. " +
+			"The source workflow is read with an `n8n_workflow` data source using a provider configured for the source instance, " +
+			"and created on the instance of the provider managing this resource. Credential and sub-workflow IDs are remapped " +
+			"to the IDs of the target instance. Changes made to the copy in n8n are reverted on the next apply.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:    true,
+				Description: "Unique identifier of the copy on the target instance.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"source_workflow_json": schema.StringAttribute{
+				CustomType: WorkflowJSONType{},
+				Required:   true,
+				Description: "The workflow to copy, usually the `workflow_json` attribute of an `n8n_workflow` data source " +
+					"read from the source instance. A workflow JSON document exported from the n8n editor works as well; " +
+					"instance-specific fields such as `id`, `versionId`, `tags` and `staticData` are not copied.",
+			},
+			"name": schema.StringAttribute{
+				Optional:    true,
+				Computed:    true,
+				Description: "Name of the copy. Defaults to the name of the source workflow.",
+			},
+			"active": schema.BoolAttribute{
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(false),
+				Description: "Whether the copy is active. Defaults to `false`.",
+			},
+			"credential_ids": schema.MapAttribute{
+				ElementType: types.StringType,
+				Optional:    true,
+				Description: "IDs of credentials on the target instance, keyed by their ID on the source instance. " +
+					"Other credentials are resolved by type and name on the target instance.",
+			},
+			"workflow_ids": schema.MapAttribute{
+				ElementType: types.StringType,
+				Optional:    true,
+				Description: "IDs of workflows on the target instance, keyed by their ID on the source instance. Used to remap " +
+					"the sub-workflows run by Execute Workflow nodes and the error workflow setting. " +
+					"Referenced workflows missing from the map keep their source ID and are reported as a warning.",
+			},
+			"workflow_json": schema.StringAttribute{
+				Computed:    true,
+				Description: "Normalized JSON document of the copy, after remapping, as used to detect changes.",
+			},
+			"version_id": schema.StringAttribute{
+				Computed:    true,
+				Description: "Identifier of the current version of the copy.",
+			},
+		},
+	}
+}
+
+// ModifyPlan computes the copy from the source workflow, so that the plan
+// shows the document applied to the target and changes made to the copy in
+// n8n are detected.
+func (r *workflowCopyResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.Plan.Raw.IsNull() || r.client == nil {
+		return
+	}
+
+	// The copy can only be computed once every input is known, such as the
+	// ID of a workflow copied by another resource.
+	if !req.Config.Raw.IsFullyKnown() {
+		return
+	}
+
+	var plan workflowCopyResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var name types.String
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("name"), &name)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	plan.Name = name
+
+	var state *workflowCopyResourceModel
+	if !req.State.Raw.IsNull() {
+		state = &workflowCopyResourceModel{}
+		resp.Diagnostics.Append(req.State.Get(ctx, state)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	// The IDs of named credentials are left out of workflow_json, so they
+	// only need resolving to report missing credentials when the source or
+	// the ID overrides change. Planning an unchanged copy does not list the
+	// credentials of the target instance.
+	var credentials credentialLister = r.client.WithContext(ctx)
+	if state != nil &&
+		plan.SourceWorkflowJSON.Equal(state.SourceWorkflowJSON) &&
+		plan.CredentialIDs.Equal(state.CredentialIDs) &&
+		plan.WorkflowIDs.Equal(state.WorkflowIDs) {
+		credentials = nil
+	}
+
+	workflow, diags := r.prepare(ctx, plan, credentials)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	plan.Name = types.StringValue(workflow.Name)
+	plan.WorkflowJSON, diags = normalizedCopyJSON(workflow)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if state != nil {
+		if plan.WorkflowJSON.Equal(state.WorkflowJSON) && plan.Active.Equal(state.Active) {
+			plan.VersionId = state.VersionId
+		} else {
+			plan.VersionId = types.StringUnknown()
+		}
+	}
+
+	resp.Diagnostics.Append(resp.Plan.Set(ctx, plan)...)
+}
+
+// Create creates the copy on the target instance and sets the initial Terraform state.
+func (r *workflowCopyResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	if !checkClient(r.client, &resp.Diagnostics) {
+		return
+	}
+
+	var plan workflowCopyResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	workflow, diags := r.prepare(ctx, plan, r.client.WithContext(ctx))
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	created, err := r.client.WithContext(ctx).CreateWorkflow(&n8n.CreateWorkflowRequest{
+		Name:        workflow.Name,
+		Nodes:       workflow.Nodes,
+		Connections: workflow.Connections,
+		Settings:    workflow.Settings,
+		PinData:     workflow.PinData,
+	})
+	if err != nil {
+		resp.Diagnostics.AddError("Error creating workflow", err.Error())
+		return
+	}
+
+	// The copy exists from here on, so it is saved to the state even when
+	// activation fails, with its actual activation state.
+	created = applyWorkflowActive(r.client.WithContext(ctx), created, plan.Active.ValueBool(), &resp.Diagnostics)
+
+	plan.ID = types.StringValue(created.ID)
+	resp.Diagnostics.Append(plan.setApplied(workflow, created)...)
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Read refreshes the Terraform state with the copy stored on the target instance.
+func (r *workflowCopyResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	if !checkClient(r.client, &resp.Diagnostics) {
+		return
+	}
+
+	var state workflowCopyResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	workflow, err := r.client.WithContext(ctx).GetWorkflow(state.ID.ValueString())
+	if n8n.IsNotFound(err) {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError("Error retrieving workflow", err.Error())
+		return
+	}
+
+	actual, diags := normalizedCopyJSON(workflow)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !state.WorkflowJSON.IsNull() && !state.WorkflowJSON.Equal(actual) {
+		drift, err := WorkflowNodeDrift(state.WorkflowJSON.ValueString(), actual.ValueString())
+		if err == nil && len(drift) > 0 {
+			resp.Diagnostics.AddWarning(
+				"Workflow changed outside of Terraform",
+				fmt.Sprintf("The copy %q was modified in n8n and will be restored from the source workflow:\n\n- %s", workflow.ID, strings.Join(drift, "\n- ")),
+			)
+		}
+	}
+
+	state.Name = types.StringValue(workflow.Name)
+	state.Active = types.BoolValue(workflow.Active)
+	state.WorkflowJSON = actual
+	state.VersionId = types.StringValue(workflow.VersionId)
+
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Update replaces the copy with the current source workflow and sets the updated Terraform state on success.
+func (r *workflowCopyResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	if !checkClient(r.client, &resp.Diagnostics) {
+		return
+	}
+
+	var plan, state workflowCopyResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	workflow, diags := r.prepare(ctx, plan, r.client.WithContext(ctx))
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	updated, err := r.client.WithContext(ctx).UpdateWorkflow(state.ID.ValueString(), &n8n.UpdateWorkflowRequest{
+		Name:        workflow.Name,
+		Nodes:       workflow.Nodes,
+		Connections: workflow.Connections,
+		Settings:    workflow.Settings,
+		PinData:     workflow.PinData,
+	})
+	if err != nil {
+		resp.Diagnostics.AddError("Error updating workflow", err.Error())
+		return
+	}
+
+	// The copy was updated, so the new document is saved to the state even
+	// when activation fails, with its actual activation state.
+	updated = applyWorkflowActive(r.client.WithContext(ctx), updated, plan.Active.ValueBool(), &resp.Diagnostics)
+
+	resp.Diagnostics.Append(plan.setApplied(workflow, updated)...)
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Delete deletes the copy from the target instance.
+func (r *workflowCopyResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	if !checkClient(r.client, &resp.Diagnostics) {
+		return
+	}
+
+	var state workflowCopyResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	_, err := r.client.WithContext(ctx).DeleteWorkflow(state.ID.ValueString())
+	if err != nil && !n8n.IsNotFound(err) {
+		resp.Diagnostics.AddError("Error deleting workflow", err.Error())
+		return
+	}
+}
+
+// prepare builds the copy of the source workflow of the model: only the
+// portable fields are kept, the name is overridden when configured, and the
+// credential and workflow IDs are remapped to the target instance. Named
+// credentials are looked up in credentials, and keep their source ID when it
+// is nil.
+func (r *workflowCopyResource) prepare(ctx context.Context, model workflowCopyResourceModel, credentials credentialLister) (*n8n.Workflow, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	source, err := ParseWorkflowJSON(model.SourceWorkflowJSON.ValueString())
+	if err != nil {
+		diags.AddAttributeError(path.Root("source_workflow_json"), "Invalid Workflow JSON", err.Error())
+		return nil, diags
+	}
+
+	workflow := &n8n.Workflow{
+		Name:        source.Name,
+		Nodes:       source.Nodes,
+		Connections: source.Connections,
+		Settings:    source.Settings,
+		PinData:     source.PinData,
+	}
+	if isKnown(model.Name) {
+		workflow.Name = model.Name.ValueString()
+	}
+
+	workflowIDs := make(map[string]string)
+	if !model.WorkflowIDs.IsNull() {
+		diags.Append(model.WorkflowIDs.ElementsAs(ctx, &workflowIDs, false)...)
+	}
+	credentialIDs := make(map[string]string)
+	if !model.CredentialIDs.IsNull() {
+		diags.Append(model.CredentialIDs.ElementsAs(ctx, &credentialIDs, false)...)
+	}
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	if unmapped := remapWorkflowReferences(workflow, workflowIDs); len(unmapped) > 0 {
+		diags.AddAttributeWarning(
+			path.Root("workflow_ids"),
+			"Unmapped Workflow References",
+			fmt.Sprintf("The source workflow references workflows missing from workflow_ids, which keep their source ID on the target instance: %s", strings.Join(unmapped, ", ")),
+		)
+	}
+
+	err = resolveCredentials(credentials, workflow, func(ref n8n.CredentialRef) (string, bool) {
+		id, ok := credentialIDs[ref.ID]
+		return id, ok && ref.ID != ""
+	})
+	if err != nil {
+		diags.AddAttributeError(path.Root("source_workflow_json"), "Unable to Resolve Workflow Credentials", err.Error())
+		return nil, diags
+	}
+
+	return workflow, diags
+}
+
+// setApplied records the copy sent to n8n and the attributes assigned by n8n.
+func (m *workflowCopyResourceModel) setApplied(sent *n8n.Workflow, stored *n8n.Workflow) diag.Diagnostics {
+	workflowJSON, diags := normalizedCopyJSON(sent)
+	m.Name = types.StringValue(stored.Name)
+	m.Active = types.BoolValue(stored.Active)
+	m.WorkflowJSON = workflowJSON
+	m.VersionId = types.StringValue(stored.VersionId)
+	return diags
+}
+
+// normalizedCopyJSON returns the normalized JSON document of a workflow, as
+// compared between the plan and the copy stored in n8n.
+func normalizedCopyJSON(workflow *n8n.Workflow) (types.String, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	data, err := json.Marshal(workflow)
+	if err == nil {
+		var normalized string
+		normalized, err = NormalizeWorkflowJSON(string(data), false)
+		if err == nil {
+			return types.StringValue(normalized), diags
+		}
+	}
+
+	diags.AddError("Error normalizing workflow", err.Error())
+	return types.StringNull(), diags
+}
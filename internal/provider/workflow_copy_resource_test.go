@@ -0,0 +1,351 @@
+// Copyright (c) Arthur Diniz <arthurbdiniz@gmail.com>
+// SPDX-License-Identifier: Apache-2.0
+
+package provider
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"regexp"
+	"sync/atomic"
+	"testing"
+
+	"github.com/arthurbdiniz/terraform-provider-n8n/internal/config"
+	"github.com/arthurbdiniz/terraform-provider-n8n/internal/pkg/n8n-client-go"
+	"github.com/arthurbdiniz/terraform-provider-n8n/internal/pkg/n8n-client-go/n8ntest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestWorkflowCopyResource(t *testing.T) {
+	staging := newTestServer(t)
+	production := newTestServer(t)
+
+	stagingSlack := staging.AddCredential(n8ntest.Credential{Name: "Slack", Type: "slackApi"})
+	production.AddCredential(n8ntest.Credential{Name: "Slack", Type: "httpHeaderAuth"})
+	productionSlack := production.AddCredential(n8ntest.Credential{Name: "Slack", Type: "slackApi"})
+	require.NotEqual(t, stagingSlack.ID, productionSlack.ID)
+
+	child := staging.AddWorkflow(n8ntest.Workflow{
+		Name:        "Notify",
+		Nodes:       json.RawMessage(`[{"id":"1","name":"Start","type":"n8n-nodes-base.executeWorkflowTrigger","typeVersion":1,"position":[0,0],"parameters":{}}]`),
+		Connections: json.RawMessage(`{}`),
+		Settings:    json.RawMessage(`{}`),
+	})
+	parent := staging.AddWorkflow(n8ntest.Workflow{
+		Name: "Orders",
+		Nodes: json.RawMessage(fmt.Sprintf(`[
+			{"id":"1","name":"Webhook","type":"n8n-nodes-base.webhook","typeVersion":2,"position":[0,0],"parameters":{"path":"orders"}},
+			{"id":"2","name":"Notify","type":"n8n-nodes-base.executeWorkflow","typeVersion":1.2,"position":[200,0],
+			 "parameters":{"workflowId":{"__rl":true,"mode":"list","value":%[1]q,"cachedResultName":"Notify","cachedResultUrl":"/workflow/%[1]s"}}},
+			{"id":"3","name":"Slack","type":"n8n-nodes-base.slack","typeVersion":2.2,"position":[400,0],"parameters":{},
+			 "credentials":{"slackApi":{"id":%[2]q,"name":"Slack"}}}
+		]`, child.ID, stagingSlack.ID)),
+		Connections: json.RawMessage(`{"Webhook":{"main":[[{"node":"Notify","type":"main","index":0}]]},"Notify":{"main":[[{"node":"Slack","type":"main","index":0}]]}}`),
+		Settings:    json.RawMessage(`{"executionOrder":"v1"}`),
+		StaticData:  json.RawMessage(`{"lastId":42}`),
+	})
+
+	stagingClient, err := n8n.NewClient(&staging.URL, &config.ApiToken)
+	require.NoError(t, err)
+	productionClient, err := n8n.NewClient(&production.URL, &config.ApiToken)
+	require.NoError(t, err)
+
+	// export returns the JSON of a staging workflow, as read by the
+	// workflow_json attribute of the n8n_workflow data source.
+	export := func(id string) string {
+		workflow, err := stagingClient.GetWorkflow(id)
+		require.NoError(t, err)
+		exported, err := ExportWorkflowJSON(workflow)
+		require.NoError(t, err)
+		return exported
+	}
+
+	configuration := func(parentJSON string) string {
+		return fmt.Sprintf(`
+			resource "n8n_workflow_copy" "child" {
+				source_workflow_json = %[1]q
+			}
+
+			resource "n8n_workflow_copy" "parent" {
+				source_workflow_json = %[2]q
+				name                 = "Orders (production)"
+				workflow_ids         = { %[3]q = n8n_workflow_copy.child.id }
+			}
+		`, export(child.ID), parentJSON, child.ID)
+	}
+
+	promoted := configuration(export(parent.ID))
+	_, err = stagingClient.UpdateWorkflowSettings(parent.ID, func(settings *n8n.Settings) {
+		settings.Timezone = "Europe/Berlin"
+	})
+	require.NoError(t, err)
+	repromoted := configuration(export(parent.ID))
+
+	copyOf := func(s *terraform.State, name string) (*n8n.Workflow, error) {
+		return productionClient.GetWorkflow(s.RootModule().Resources[name].Primary.ID)
+	}
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testProtoV6ProviderFactoriesForURL(t, production.URL),
+		CheckDestroy: func(*terraform.State) error {
+			require.Empty(t, production.Workflows(), "destroy must delete the copies")
+			require.Len(t, staging.Workflows(), 2, "destroy must leave the source workflows")
+			return nil
+		},
+		Steps: []resource.TestStep{
+			// Create copies the workflows and remaps the references
+			{
+				Config: promoted,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("n8n_workflow_copy.parent", "name", "Orders (production)"),
+					resource.TestCheckResourceAttr("n8n_workflow_copy.parent", "active", "false"),
+					resource.TestCheckResourceAttrSet("n8n_workflow_copy.parent", "version_id"),
+					func(s *terraform.State) error {
+						copied, err := copyOf(s, "n8n_workflow_copy.parent")
+						if err != nil {
+							return err
+						}
+						childCopy := s.RootModule().Resources["n8n_workflow_copy.child"].Primary.ID
+
+						require.Len(t, production.Workflows(), 2)
+						assert.Equal(t, "Orders (production)", copied.Name)
+						assert.Equal(t, map[string]interface{}{
+							"__rl":             true,
+							"mode":             "list",
+							"value":            childCopy,
+							"cachedResultName": "Notify",
+							"cachedResultUrl":  "/workflow/" + childCopy,
+						}, copied.Nodes[1].Parameters["workflowId"])
+						assert.Equal(t, n8n.CredentialRef{ID: productionSlack.ID, Name: "Slack"}, copied.Nodes[2].Credentials["slackApi"])
+						assert.Equal(t, "v1", copied.Settings.ExecutionOrder)
+						assert.NotContains(t, string(copied.StaticData), "lastId", "static data is instance specific")
+						return nil
+					},
+				),
+			},
+			// The copies are stable
+			{
+				Config:   promoted,
+				PlanOnly: true,
+			},
+			// Changes made to a copy are drift
+			{
+				PreConfig: func() {
+					for _, workflow := range production.Workflows() {
+						if workflow.Name == "Orders (production)" {
+							_, err := productionClient.UpdateWorkflowSettings(workflow.ID, func(settings *n8n.Settings) {
+								settings.ExecutionOrder = "v0"
+							})
+							require.NoError(t, err)
+						}
+					}
+				},
+				Config:             promoted,
+				PlanOnly:           true,
+				ExpectNonEmptyPlan: true,
+			},
+			// Apply restores the copy
+			{
+				Config: promoted,
+				Check: func(s *terraform.State) error {
+					copied, err := copyOf(s, "n8n_workflow_copy.parent")
+					if err != nil {
+						return err
+					}
+					assert.Equal(t, "v1", copied.Settings.ExecutionOrder)
+					return nil
+				},
+			},
+			// Changes to the source are copied
+			{
+				Config: repromoted,
+				Check: func(s *terraform.State) error {
+					copied, err := copyOf(s, "n8n_workflow_copy.parent")
+					if err != nil {
+						return err
+					}
+					assert.Equal(t, "Europe/Berlin", copied.Settings.Timezone)
+					return nil
+				},
+			},
+		},
+	})
+}
+
+func TestWorkflowCopyResource_ActivationFailure(t *testing.T) {
+	server := newTestServer(t)
+	server.CanActivate = func(n8ntest.Workflow) error {
+		return errors.New("activation refused")
+	}
+
+	configuration := func(name string, active bool) string {
+		return fmt.Sprintf(`
+			resource "n8n_workflow_copy" "test" {
+				active               = %t
+				source_workflow_json = jsonencode({
+					name = %q
+					nodes = [{
+						id          = "1"
+						name        = "Every Hour"
+						type        = "n8n-nodes-base.scheduleTrigger"
+						typeVersion = 1.2
+						position    = [0, 0]
+						parameters  = {}
+					}]
+					connections = {}
+					settings    = { executionOrder = "v1" }
+				})
+			}
+		`, active, name)
+	}
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testProtoV6ProviderFactoriesForURL(t, server.URL),
+		Steps: []resource.TestStep{
+			// A copy failing to activate on create is still tracked
+			{
+				Config:      configuration("Created", true),
+				ExpectError: regexp.MustCompile(`activation refused`),
+			},
+			{
+				PreConfig: func() {
+					require.Len(t, server.Workflows(), 1)
+					server.CanActivate = nil
+				},
+				Config: configuration("Created", true),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("n8n_workflow_copy.test", "active", "true"),
+					func(*terraform.State) error {
+						require.Len(t, server.Workflows(), 1, "the copy must not be created twice")
+						return nil
+					},
+				),
+			},
+			{
+				Config: configuration("Created", false),
+			},
+			// The updated copy is saved when activation fails on update
+			{
+				PreConfig: func() {
+					server.CanActivate = func(n8ntest.Workflow) error {
+						return errors.New("activation refused")
+					}
+				},
+				Config:      configuration("Updated", true),
+				ExpectError: regexp.MustCompile(`activation refused`),
+			},
+			{
+				Config:   configuration("Updated", false),
+				PlanOnly: true,
+			},
+		},
+	})
+}
+
+func TestWorkflowCopyResource_CredentialLookups(t *testing.T) {
+	server := newTestServer(t)
+	slack := server.AddCredential(n8ntest.Credential{Name: "Slack", Type: "slackApi"})
+
+	// lookups counts the requests listing the credentials of the instance.
+	var lookups atomic.Int32
+	proxy := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == http.MethodGet && r.URL.Path == "/api/v1/credentials" {
+			lookups.Add(1)
+		}
+		server.Config.Handler.ServeHTTP(w, r)
+	}))
+	t.Cleanup(proxy.Close)
+
+	configuration := func(timezone string) string {
+		return fmt.Sprintf(`
+			resource "n8n_workflow_copy" "test" {
+				source_workflow_json = jsonencode({
+					name = "Orders"
+					nodes = [{
+						id          = "1"
+						name        = "Slack"
+						type        = "n8n-nodes-base.slack"
+						typeVersion = 2.2
+						position    = [0, 0]
+						parameters  = {}
+						credentials = { slackApi = { id = "StagingSlack0001", name = "Slack" } }
+					}]
+					connections = {}
+					settings    = { executionOrder = "v1", timezone = %q }
+				})
+			}
+		`, timezone)
+	}
+
+	checkLookups := func(expectListed bool) resource.TestCheckFunc {
+		return func(*terraform.State) error {
+			if listed := lookups.Swap(0) > 0; listed != expectListed {
+				return fmt.Errorf("expected credentials listed to be %t, got %t", expectListed, listed)
+			}
+			return nil
+		}
+	}
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testProtoV6ProviderFactoriesForURL(t, proxy.URL),
+		Steps: []resource.TestStep{
+			{
+				Config: configuration("UTC"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					func(*terraform.State) error {
+						workflows := server.Workflows()
+						require.Len(t, workflows, 1)
+						require.Contains(t, string(workflows[0].Nodes), slack.ID, "the copy must use the target credential")
+						return nil
+					},
+					checkLookups(true),
+				),
+			},
+			// Planning an unchanged copy resolves the credentials it uses
+			{
+				Config:   configuration("UTC"),
+				PlanOnly: true,
+			},
+			// Changing the source resolves the credentials again
+			{
+				PreConfig: func() {
+					require.Zero(t, lookups.Load(), "an unchanged copy must not list credentials")
+				},
+				Config: configuration("Europe/Berlin"),
+				Check:  checkLookups(true),
+			},
+		},
+	})
+}
+
+func TestRemapWorkflowReferences(t *testing.T) {
+	workflow := &n8n.Workflow{
+		Nodes: []n8n.Node{
+			{Name: "Legacy", Type: "n8n-nodes-base.executeWorkflow", Parameters: map[string]interface{}{"workflowId": "11"}},
+			{Name: "Locator", Type: "n8n-nodes-base.executeWorkflow", Parameters: map[string]interface{}{
+				"workflowId": map[string]interface{}{"__rl": true, "mode": "id", "value": "12"},
+			}},
+			{Name: "Expression", Type: "n8n-nodes-base.executeWorkflow", Parameters: map[string]interface{}{"workflowId": "={{ $json.id }}"}},
+			{Name: "From URL", Type: "n8n-nodes-base.executeWorkflow", Parameters: map[string]interface{}{"source": "url", "workflowId": "13"}},
+			{Name: "Tool", Type: "@n8n/n8n-nodes-langchain.toolWorkflow", Parameters: map[string]interface{}{"workflowId": "14"}},
+			{Name: "Set", Type: "n8n-nodes-base.set", Parameters: map[string]interface{}{"workflowId": "15"}},
+		},
+		Settings: n8n.Settings{ErrorWorkflow: "16"},
+	}
+
+	unmapped := remapWorkflowReferences(workflow, map[string]string{"11": "21", "12": "22", "16": "26"})
+
+	assert.Equal(t, []string{"14"}, unmapped)
+	assert.Equal(t, "21", workflow.Nodes[0].Parameters["workflowId"])
+	assert.Equal(t, "22", workflow.Nodes[1].Parameters["workflowId"].(map[string]interface{})["value"])
+	assert.Equal(t, "={{ $json.id }}", workflow.Nodes[2].Parameters["workflowId"])
+	assert.Equal(t, "13", workflow.Nodes[3].Parameters["workflowId"])
+	assert.Equal(t, "15", workflow.Nodes[5].Parameters["workflowId"])
+	assert.Equal(t, "26", workflow.Settings.ErrorWorkflow)
+}
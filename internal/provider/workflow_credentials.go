@@ -0,0 +1,103 @@
+// Copyright (c) Arthur Diniz <arthurbdiniz@gmail.com>
+// SPDX-License-Identifier: Apache-2.0
+
+package provider
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"sort"
+
+	"github.com/arthurbdiniz/terraform-provider-n8n/internal/pkg/n8n-client-go"
+)
+
+// credentialLister lists the credentials of an n8n instance.
+type credentialLister interface {
+	GetCredentials() (*n8n.CredentialsResponse, error)
+}
+
+// credentialIDFunc returns the ID to use for a credential reference, if any.
+type credentialIDFunc func(ref n8n.CredentialRef) (string, bool)
+
+// credentialIDsByName returns a credentialIDFunc looking up references by
+// credential name in ids.
+func credentialIDsByName(ids map[string]string) credentialIDFunc {
+	return func(ref n8n.CredentialRef) (string, bool) {
+		id, ok := ids[ref.Name]
+		return id, ok
+	}
+}
+
+// resolveCredentials replaces the ID of every named credential referenced by
+// the nodes of workflow with the ID of the credential of the same type and
+// name on the instance, so a workflow exported from one instance can be
+// applied to another. IDs returned by explicitID take precedence; the
+// instance credentials are only listed when a reference is not covered by
+// them. Other references without a name, and every other reference when
+// lister is nil, are left unchanged.
+func resolveCredentials(lister credentialLister, workflow *n8n.Workflow, explicitID credentialIDFunc) error {
+	// credentialKey identifies a credential by type and name.
+	type credentialKey struct{ Type, Name string }
+	var byKey map[credentialKey][]string
+
+	var errs []error
+	for i := range workflow.Nodes {
+		node := &workflow.Nodes[i]
+
+		credentialTypes := make([]string, 0, len(node.Credentials))
+		for credentialType := range node.Credentials {
+			credentialTypes = append(credentialTypes, credentialType)
+		}
+		sort.Strings(credentialTypes)
+
+		for _, credentialType := range credentialTypes {
+			ref := node.Credentials[credentialType]
+			if explicitID != nil {
+				if id, ok := explicitID(ref); ok {
+					ref.ID = id
+					node.Credentials[credentialType] = ref
+					continue
+				}
+			}
+			if ref.Name == "" || lister == nil {
+				continue
+			}
+
+			if byKey == nil {
+				credentials, err := lister.GetCredentials()
+				if err != nil {
+					return credentialListError(err)
+				}
+				byKey = make(map[credentialKey][]string, len(credentials.Data))
+				for _, credential := range credentials.Data {
+					key := credentialKey{credential.Type, credential.Name}
+					byKey[key] = append(byKey[key], credential.ID)
+				}
+			}
+
+			switch ids := byKey[credentialKey{credentialType, ref.Name}]; len(ids) {
+			case 0:
+				errs = append(errs, fmt.Errorf("node %q: no %s credential named %q was found", node.Name, credentialType, ref.Name))
+			case 1:
+				ref.ID = ids[0]
+				node.Credentials[credentialType] = ref
+			default:
+				errs = append(errs, fmt.Errorf("node %q: %d %s credentials are named %q, set its ID in credential_ids", node.Name, len(ids), credentialType, ref.Name))
+			}
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
+// credentialListError explains that credentials cannot be resolved by name
+// when the instance does not support listing them.
+func credentialListError(err error) error {
+	var apiErr *n8n.APIError
+	if errors.As(err, &apiErr) && (apiErr.StatusCode == http.StatusNotFound || apiErr.StatusCode == http.StatusMethodNotAllowed) {
+		return fmt.Errorf("this version of n8n cannot list credentials through its public API, "+
+			"set the IDs of the credentials used by the workflow in credential_ids: %w", err)
+	}
+	return fmt.Errorf("unable to list credentials: %w", err)
+}
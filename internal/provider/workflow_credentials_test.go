@@ -0,0 +1,172 @@
+// Copyright (c) Arthur Diniz <arthurbdiniz@gmail.com>
+// SPDX-License-Identifier: Apache-2.0
+
+package provider
+
+import (
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/arthurbdiniz/terraform-provider-n8n/internal/config"
+	"github.com/arthurbdiniz/terraform-provider-n8n/internal/pkg/n8n-client-go"
+	"github.com/arthurbdiniz/terraform-provider-n8n/internal/pkg/n8n-client-go/n8ntest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// stubCredentialLister returns a fixed list of credentials and counts calls.
+type stubCredentialLister struct {
+	credentials []n8n.Credential
+	err         error
+	calls       int
+}
+
+func (s *stubCredentialLister) GetCredentials() (*n8n.CredentialsResponse, error) {
+	s.calls++
+	if s.err != nil {
+		return nil, s.err
+	}
+	return &n8n.CredentialsResponse{Data: s.credentials}, nil
+}
+
+func credentialWorkflow(refs ...map[string]n8n.CredentialRef) *n8n.Workflow {
+	workflow := &n8n.Workflow{Name: "Credentials"}
+	for i, credentials := range refs {
+		workflow.Nodes = append(workflow.Nodes, n8n.Node{Name: fmt.Sprintf("Node %d", i), Credentials: credentials})
+	}
+	return workflow
+}
+
+func TestResolveCredentials(t *testing.T) {
+	lister := &stubCredentialLister{credentials: []n8n.Credential{
+		{ID: "10", Name: "Slack", Type: "slackApi"},
+		{ID: "11", Name: "Slack", Type: "slackOAuth2Api"},
+		{ID: "12", Name: "Postgres", Type: "postgres"},
+	}}
+	workflow := credentialWorkflow(
+		map[string]n8n.CredentialRef{"slackApi": {ID: "dev-1", Name: "Slack"}},
+		map[string]n8n.CredentialRef{"postgres": {ID: "dev-2", Name: "Postgres"}, "httpHeaderAuth": {ID: "7"}},
+		nil,
+	)
+
+	require.NoError(t, resolveCredentials(lister, workflow, nil))
+
+	assert.Equal(t, n8n.CredentialRef{ID: "10", Name: "Slack"}, workflow.Nodes[0].Credentials["slackApi"])
+	assert.Equal(t, n8n.CredentialRef{ID: "12", Name: "Postgres"}, workflow.Nodes[1].Credentials["postgres"])
+	assert.Equal(t, n8n.CredentialRef{ID: "7"}, workflow.Nodes[1].Credentials["httpHeaderAuth"], "unnamed references are left unchanged")
+	assert.Equal(t, 1, lister.calls, "credentials are listed once")
+}
+
+func TestResolveCredentials_ExplicitIDs(t *testing.T) {
+	lister := &stubCredentialLister{err: &n8n.APIError{StatusCode: 404, Body: "not found"}}
+	workflow := credentialWorkflow(map[string]n8n.CredentialRef{"slackApi": {ID: "dev-1", Name: "Slack"}})
+
+	require.NoError(t, resolveCredentials(lister, workflow, credentialIDsByName(map[string]string{"Slack": "99"})))
+
+	assert.Equal(t, "99", workflow.Nodes[0].Credentials["slackApi"].ID)
+	assert.Zero(t, lister.calls, "credentials are not listed when every reference has an explicit ID")
+}
+
+func TestResolveCredentials_Errors(t *testing.T) {
+	lister := &stubCredentialLister{credentials: []n8n.Credential{
+		{ID: "10", Name: "Slack", Type: "slackApi"},
+		{ID: "11", Name: "Slack", Type: "slackApi"},
+	}}
+	workflow := credentialWorkflow(
+		map[string]n8n.CredentialRef{"slackApi": {Name: "Slack"}},
+		map[string]n8n.CredentialRef{"postgres": {Name: "Postgres"}},
+	)
+
+	err := resolveCredentials(lister, workflow, nil)
+
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), `node "Node 0": 2 slackApi credentials are named "Slack", set its ID in credential_ids`)
+	assert.Contains(t, err.Error(), `node "Node 1": no postgres credential named "Postgres" was found`)
+}
+
+func TestResolveCredentials_ListingUnsupported(t *testing.T) {
+	lister := &stubCredentialLister{err: &n8n.APIError{StatusCode: 405, Body: "method not allowed"}}
+	workflow := credentialWorkflow(map[string]n8n.CredentialRef{"slackApi": {Name: "Slack"}})
+
+	err := resolveCredentials(lister, workflow, nil)
+
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "cannot list credentials through its public API")
+	assert.Contains(t, err.Error(), "credential_ids")
+}
+
+func TestWorkflowResource_Credentials(t *testing.T) {
+	server := newTestServer(t)
+	slack := server.AddCredential(n8ntest.Credential{Name: "Slack", Type: "slackApi", Data: map[string]interface{}{"accessToken": "secret"}})
+	client, err := n8n.NewClient(&server.URL, &config.ApiToken)
+	require.NoError(t, err)
+
+	configuration := `
+		resource "n8n_workflow" "test" {
+			workflow_json = jsonencode({
+				name = "Notify"
+				nodes = [{
+					id          = "1"
+					name        = "Notify"
+					type        = "n8n-nodes-base.slack"
+					typeVersion = 2.2
+					position    = [0, 0]
+					parameters  = {}
+					credentials = { slackApi = { id = "DevSlackCred0001", name = "Slack" } }
+				}]
+				connections = {}
+			})
+		}
+	`
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testProtoV6ProviderFactoriesForURL(t, server.URL),
+		Steps: []resource.TestStep{
+			{
+				Config: configuration,
+				Check: func(s *terraform.State) error {
+					id := s.RootModule().Resources["n8n_workflow.test"].Primary.ID
+					workflow, err := client.GetWorkflow(id)
+					if err != nil {
+						return err
+					}
+					require.Equal(t, n8n.CredentialRef{ID: slack.ID, Name: "Slack"}, workflow.Nodes[0].Credentials["slackApi"])
+					return nil
+				},
+			},
+			// The instance-specific ID is not drift
+			{
+				Config:   configuration,
+				PlanOnly: true,
+			},
+		},
+	})
+}
+
+func TestWorkflowResource_CredentialNotFound(t *testing.T) {
+	server := newTestServer(t)
+	nodes, err := json.Marshal([]n8n.Node{{
+		Name:        "Notify",
+		Type:        "n8n-nodes-base.slack",
+		Credentials: map[string]n8n.CredentialRef{"slackApi": {Name: "Slack"}},
+	}})
+	require.NoError(t, err)
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testProtoV6ProviderFactoriesForURL(t, server.URL),
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+					resource "n8n_workflow" "test" {
+						workflow_json = jsonencode({ name = "Notify", nodes = jsondecode(%q), connections = {} })
+					}
+				`, string(nodes)),
+				ExpectError: regexp.MustCompile(`no slackApi credential named "Slack" was found`),
+			},
+		},
+	})
+}
@@ -6,16 +6,19 @@ package provider
 import (
 	"context"
 	"fmt"
+	"strings"
 
 	"github.com/arthurbdiniz/terraform-provider-n8n/internal/pkg/n8n-client-go"
+	"github.com/hashicorp/terraform-plugin-framework-validators/datasourcevalidator"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
-	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 )
 
 // Ensure the implementation satisfies the expected interfaces.
 var _ datasource.DataSource = &workflowDataSource{}
 var _ datasource.DataSourceWithConfigure = &workflowDataSource{}
+var _ datasource.DataSourceWithConfigValidators = &workflowDataSource{}
 
 // NewWorkflowDataSource returns a new data source.
 func NewWorkflowDataSource() datasource.DataSource {
@@ -26,20 +29,6 @@ type workflowDataSource struct {
 	client *n8n.Client
 }
 
-type workflowDataSourceModel struct {
-	ID           types.String   `tfsdk:"id"`
-	Name         types.String   `tfsdk:"name"`
-	Active       types.Bool     `tfsdk:"active"`
-	VersionId    types.String   `tfsdk:"version_id"`
-	TriggerCount types.Int64    `tfsdk:"trigger_count"`
-	CreatedAt    types.String   `tfsdk:"created_at"`
-	UpdatedAt    types.String   `tfsdk:"updated_at"`
-	Nodes        []nodesModel   `tfsdk:"nodes"`
-	Connections  types.String   `tfsdk:"connections"`
-	Settings     *settingsModel `tfsdk:"settings"`
-	Tags         []tagsModel    `tfsdk:"tags"`
-}
-
 func (d *workflowDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
 	if req.ProviderData == nil {
 		return
@@ -58,15 +47,17 @@ func (d *workflowDataSource) Metadata(_ context.Context, req datasource.Metadata
 
 func (d *workflowDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
 	resp.Schema = schema.Schema{
-		Description: "Fetch a single workflow by ID.",
+		Description: "Fetch a single workflow by ID or name.",
 		Attributes: map[string]schema.Attribute{
 			"id": schema.StringAttribute{
-				Required:    true,
-				Description: "Workflow ID.",
+				Optional:    true,
+				Computed:    true,
+				Description: "Workflow ID. Exactly one of `id` or `name` must be set.",
 			},
 			"name": schema.StringAttribute{
+				Optional:    true,
 				Computed:    true,
-				Description: "Name of the workflow.",
+				Description: "Name of the workflow. Exactly one of `id` or `name` must be set; the lookup fails unless exactly one workflow has this name.",
 			},
 			"active": schema.BoolAttribute{
 				Computed:    true,
@@ -90,93 +81,125 @@ func (d *workflowDataSource) Schema(_ context.Context, _ datasource.SchemaReques
 			},
 			"nodes": workflowsNodeAttr(),
 			"connections": schema.StringAttribute{
+				CustomType:  WorkflowJSONType{},
 				Computed:    true,
 				Description: "JSON-encoded connections data.",
 			},
 			"settings": workflowsSettingsAttr(),
 			"tags":     workflowsTagsAttr(),
+			"webhooks": workflowsWebhooksAttr(),
+			"pin_data": schema.StringAttribute{
+				Computed:    true,
+				Description: "JSON-encoded data pinned to nodes, keyed by node name.",
+			},
+			"static_data": schema.StringAttribute{
+				Computed:    true,
+				Description: "JSON-encoded data persisted by the workflow between executions.",
+			},
+			"meta": schema.StringAttribute{
+				Computed:    true,
+				Description: "JSON-encoded workflow editor metadata.",
+			},
+			"workflow_json": schema.StringAttribute{
+				CustomType: WorkflowJSONType{},
+				Computed:   true,
+				Description: "The workflow as a JSON document without instance-specific fields such as the ID, version, " +
+					"tags and static data, like a workflow exported from the n8n editor. Use it with `n8n_workflow_copy` " +
+					"to copy the workflow to another instance.",
+			},
 		},
 	}
 }
 
+func (d *workflowDataSource) ConfigValidators(_ context.Context) []datasource.ConfigValidator {
+	return []datasource.ConfigValidator{
+		datasourcevalidator.ExactlyOneOf(
+			path.MatchRoot("id"),
+			path.MatchRoot("name"),
+		),
+	}
+}
+
 func (d *workflowDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
-	var state workflowDataSourceModel
-	diags := req.Config.Get(ctx, &state)
-	resp.Diagnostics.Append(diags...)
-	if resp.Diagnostics.HasError() {
+	if !checkClient(d.client, &resp.Diagnostics) {
 		return
 	}
 
-	workflowID := state.ID.ValueString()
-	workflow, err := d.client.GetWorkflow(workflowID)
-	if err != nil {
-		resp.Diagnostics.AddError("Error retrieving workflow", err.Error())
+	var state workflowModel
+	diags := req.Config.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
 		return
 	}
 
-	// Nodes
-	var nodes []nodesModel
-	for _, node := range workflow.Nodes {
-		var positions []types.Int64
-		for _, p := range node.Position {
-			positions = append(positions, types.Int64Value(int64(p)))
+	var workflow *n8n.Workflow
+	if !state.ID.IsNull() {
+		var err error
+		workflow, err = d.client.WithContext(ctx).GetWorkflow(state.ID.ValueString())
+		if n8n.IsNotFound(err) {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("id"),
+				"Workflow Not Found",
+				fmt.Sprintf("No workflow with ID %q was found.", state.ID.ValueString()),
+			)
+			return
 		}
-
-		parameters, err := ConvertToTerraformList(node.Parameters)
 		if err != nil {
-			resp.Diagnostics.AddError("Error converting node parameters", err.Error())
+			resp.Diagnostics.AddError("Error retrieving workflow", err.Error())
+			return
+		}
+	} else {
+		workflow = d.findWorkflowByName(ctx, state.Name.ValueString(), resp)
+		if resp.Diagnostics.HasError() {
 			return
 		}
-
-		nodes = append(nodes, nodesModel{
-			ID:          types.StringValue(node.ID),
-			Name:        types.StringValue(node.Name),
-			Type:        types.StringValue(node.Type),
-			TypeVersion: types.Float64Value(float64(node.TypeVersion)),
-			Parameters:  parameters,
-			Position:    positions,
-		})
 	}
 
-	// Tags
-	var tags []tagsModel
-	for _, tag := range workflow.Tags {
-		tags = append(tags, tagsModel{
-			CreatedAt: types.StringValue(tag.CreatedAt),
-			UpdatedAt: types.StringValue(tag.UpdatedAt),
-			ID:        types.StringValue(tag.ID),
-			Name:      types.StringValue(tag.Name),
-		})
+	state, diags = newWorkflowModel(path.Empty(), workflow, d.client.HostURL)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
 	}
 
-	connectionsJSON, err := ConvertConnectionsToTerraformMap(workflow.Connections)
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+}
+
+// findWorkflowByName resolves a workflow name to the single workflow carrying it.
+func (d *workflowDataSource) findWorkflowByName(ctx context.Context, name string, resp *datasource.ReadResponse) *n8n.Workflow {
+	workflows, err := d.client.WithContext(ctx).GetWorkflowsByName(name)
 	if err != nil {
-		resp.Diagnostics.AddError("Failed to marshal connections", err.Error())
-		return
+		resp.Diagnostics.AddError("Error retrieving workflows", err.Error())
+		return nil
 	}
 
-	state.ID = types.StringValue(workflow.ID)
-	state.Name = types.StringValue(workflow.Name)
-	state.Active = types.BoolValue(workflow.Active)
-	state.VersionId = types.StringValue(workflow.VersionId)
-	state.TriggerCount = types.Int64Value(int64(workflow.TriggerCount))
-	state.CreatedAt = types.StringValue(workflow.CreatedAt)
-	state.UpdatedAt = types.StringValue(workflow.UpdatedAt)
-	state.Nodes = nodes
-	state.Connections = connectionsJSON
-	state.Settings = &settingsModel{
-		SaveExecutionProgress:    types.BoolValue(workflow.Settings.SaveExecutionProgress),
-		SaveManualExecutions:     types.BoolValue(workflow.Settings.SaveManualExecutions),
-		SaveDataErrorExecution:   types.StringValue(workflow.Settings.SaveDataErrorExecution),
-		SaveDataSuccessExecution: types.StringValue(workflow.Settings.SaveDataSuccessExecution),
-		ExecutionTimeout:         types.Int64Value(int64(workflow.Settings.ExecutionTimeout)),
-		ErrorWorkflow:            types.StringValue(workflow.Settings.ErrorWorkflow),
-		Timezone:                 types.StringValue(workflow.Settings.Timezone),
-		ExecutionOrder:           types.StringValue(workflow.Settings.ExecutionOrder),
+	var matches []n8n.Workflow
+	var ids []string
+	for _, workflow := range workflows.Data {
+		if workflow.Name == name {
+			matches = append(matches, workflow)
+			ids = append(ids, workflow.ID)
+		}
 	}
 
-	state.Tags = tags
+	switch len(matches) {
+	case 0:
+		resp.Diagnostics.AddAttributeError(
+			path.Root("name"),
+			"Workflow Not Found",
+			fmt.Sprintf("No workflow named %q was found.", name),
+		)
+		return nil
+	case 1:
+	default:
+		resp.Diagnostics.AddAttributeError(
+			path.Root("name"),
+			"Multiple Workflows Found",
+			fmt.Sprintf("Found %d workflows named %q (IDs: %s). Rename the workflows or look the workflow up by id instead.",
+				len(matches), name, strings.Join(ids, ", ")),
+		)
+		return nil
+	}
 
-	diags = resp.State.Set(ctx, &state)
-	resp.Diagnostics.Append(diags...)
+	return &matches[0]
 }
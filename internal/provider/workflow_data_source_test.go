@@ -4,12 +4,16 @@
 package provider
 
 import (
+	"encoding/json"
 	"fmt"
+	"net/http"
+	"regexp"
 	"testing"
 
 	"github.com/arthurbdiniz/terraform-provider-n8n/internal/config"
 	"github.com/arthurbdiniz/terraform-provider-n8n/internal/helpers"
 	"github.com/arthurbdiniz/terraform-provider-n8n/internal/pkg/n8n-client-go"
+	"github.com/arthurbdiniz/terraform-provider-n8n/internal/pkg/n8n-client-go/n8ntest"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/stretchr/testify/require"
 )
@@ -34,7 +38,7 @@ func TestWorkflowDataSource(t *testing.T) {
 				Name:        "Start",
 				Type:        "n8n-nodes-base.start",
 				TypeVersion: 1,
-				Position:    []int{0, 0},
+				Position:    []float64{0, 0},
 				Parameters:  map[string]interface{}{},
 			},
 		},
@@ -100,10 +104,227 @@ func TestWorkflowDataSource(t *testing.T) {
 					resource.TestCheckResourceAttr("data.n8n_workflow.test", "nodes.0.name", createdWorkflow.Nodes[0].Name),
 					resource.TestCheckResourceAttr("data.n8n_workflow.test", "nodes.0.type", createdWorkflow.Nodes[0].Type),
 					resource.TestCheckResourceAttr("data.n8n_workflow.test", "nodes.0.type_version", fmt.Sprintf("%g", createdWorkflow.Nodes[0].TypeVersion)),
-					resource.TestCheckResourceAttr("data.n8n_workflow.test", "nodes.0.position.0", fmt.Sprintf("%d", createdWorkflow.Nodes[0].Position[0])),
-					resource.TestCheckResourceAttr("data.n8n_workflow.test", "nodes.0.position.1", fmt.Sprintf("%d", createdWorkflow.Nodes[0].Position[1])),
+					resource.TestCheckResourceAttr("data.n8n_workflow.test", "nodes.0.position.0", fmt.Sprintf("%g", createdWorkflow.Nodes[0].Position[0])),
+					resource.TestCheckResourceAttr("data.n8n_workflow.test", "nodes.0.position.1", fmt.Sprintf("%g", createdWorkflow.Nodes[0].Position[1])),
+					resource.TestCheckResourceAttr("data.n8n_workflow.test", "nodes.0.parameters_json", "{}"),
+				),
+			},
+			{
+				Config: GetProviderConfig(url) + fmt.Sprintf(`
+					data "n8n_workflow" "test" {
+						name = "%s"
+					}
+				`, createdWorkflow.Name),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.n8n_workflow.test", "id", createdWorkflow.ID),
+					resource.TestCheckResourceAttr("data.n8n_workflow.test", "name", createdWorkflow.Name),
+				),
+			},
+			{
+				Config: GetProviderConfig(url) + `
+					data "n8n_workflow" "test" {
+						name = "Missing Workflow"
+					}
+				`,
+				ExpectError: regexp.MustCompile(`No workflow named "Missing Workflow" was found`),
+			},
+			{
+				Config: GetProviderConfig(url) + fmt.Sprintf(`
+					data "n8n_workflow" "test" {
+						id   = "%s"
+						name = "%s"
+					}
+				`, createdWorkflow.ID, createdWorkflow.Name),
+				ExpectError: regexp.MustCompile(`Invalid Attribute Combination`),
+			},
+		},
+	})
+}
+
+func TestWorkflowDataSource_ByID(t *testing.T) {
+	server := newTestServer(t)
+	tag := server.AddTag(n8ntest.Tag{Name: "prod"})
+	workflow := server.AddWorkflow(n8ntest.Workflow{
+		Name:        "Test Workflow",
+		Nodes:       json.RawMessage(`[{"id": "1", "name": "Start", "type": "n8n-nodes-base.manualTrigger", "typeVersion": 1, "position": [0, 0], "parameters": {"mode": "test"}}]`),
+		Connections: json.RawMessage(`{"Start": {"main": [[{"node": "End", "type": "main", "index": 0}]]}}`),
+		Settings:    json.RawMessage(`{"executionOrder": "v1", "timezone": "America/New_York"}`),
+		PinData:     json.RawMessage(`{"Start": [{"json": {"id": 1}}]}`),
+		Tags:        []n8ntest.Tag{{ID: tag.ID}},
+	})
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testProtoV6ProviderFactoriesForURL(t, server.URL),
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+					data "n8n_workflow" "test" {
+						id = "%s"
+					}
+				`, workflow.ID),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.n8n_workflow.test", "name", workflow.Name),
+					resource.TestCheckResourceAttr("data.n8n_workflow.test", "active", "false"),
+					resource.TestCheckResourceAttr("data.n8n_workflow.test", "version_id", workflow.VersionID),
+					resource.TestCheckResourceAttr("data.n8n_workflow.test", "created_at", workflow.CreatedAt),
+					resource.TestCheckResourceAttr("data.n8n_workflow.test", "settings.timezone", "America/New_York"),
+					resource.TestCheckResourceAttr("data.n8n_workflow.test", "settings.execution_order", "v1"),
+					resource.TestCheckResourceAttr("data.n8n_workflow.test", "nodes.#", "1"),
+					resource.TestCheckResourceAttr("data.n8n_workflow.test", "nodes.0.name", "Start"),
+					resource.TestCheckResourceAttr("data.n8n_workflow.test", "nodes.0.parameters_json", `{"mode":"test"}`),
+					resource.TestCheckResourceAttr("data.n8n_workflow.test", "connections", `{"Start":{"main":[[{"node":"End","type":"main","index":0}]]}}`),
+					resource.TestCheckResourceAttr("data.n8n_workflow.test", "tags.#", "1"),
+					resource.TestCheckResourceAttr("data.n8n_workflow.test", "tags.0.name", "prod"),
+					resource.TestCheckResourceAttr("data.n8n_workflow.test", "pin_data", `{"Start":[{"json":{"id":1}}]}`),
+					resource.TestCheckNoResourceAttr("data.n8n_workflow.test", "static_data"),
+					resource.TestCheckResourceAttrWith("data.n8n_workflow.test", "workflow_json", func(value string) error {
+						parsed, err := ParseWorkflowJSON(value)
+						if err != nil {
+							return err
+						}
+						if parsed.Name != workflow.Name || parsed.ID != "" || parsed.VersionId != "" {
+							return fmt.Errorf("unexpected exported workflow: %s", value)
+						}
+						return nil
+					}),
 				),
 			},
 		},
 	})
 }
+
+func TestWorkflowDataSource_ByName(t *testing.T) {
+	server := newTestServer(t)
+	server.PageSize = 1
+	server.AddWorkflow(n8ntest.Workflow{Name: "Other"})
+	workflow := server.AddWorkflow(n8ntest.Workflow{Name: "Test Workflow"})
+	server.AddWorkflow(n8ntest.Workflow{Name: "Duplicate"})
+	server.AddWorkflow(n8ntest.Workflow{Name: "Duplicate"})
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testProtoV6ProviderFactoriesForURL(t, server.URL),
+		Steps: []resource.TestStep{
+			{
+				Config: `
+					data "n8n_workflow" "test" {
+						name = "Test Workflow"
+					}
+				`,
+				Check: resource.TestCheckResourceAttr("data.n8n_workflow.test", "id", workflow.ID),
+			},
+			{
+				Config: `
+					data "n8n_workflow" "test" {
+						name = "Duplicate"
+					}
+				`,
+				ExpectError: regexp.MustCompile(`Multiple Workflows Found`),
+			},
+			{
+				Config: `
+					data "n8n_workflow" "test" {
+						name = "Missing Workflow"
+					}
+				`,
+				ExpectError: regexp.MustCompile(`No workflow named "Missing Workflow" was found`),
+			},
+		},
+	})
+}
+
+func TestWorkflowDataSource_Webhooks(t *testing.T) {
+	server := newTestServer(t)
+	workflow := server.AddWorkflow(n8ntest.Workflow{
+		Name: "Orders",
+		Nodes: json.RawMessage(`[
+			{"id": "1", "name": "Webhook", "type": "n8n-nodes-base.webhook", "typeVersion": 2, "position": [0, 0],
+			 "parameters": {"path": "orders", "httpMethod": "POST"}, "webhookId": "0c6d8b64"},
+			{"id": "2", "name": "Set", "type": "n8n-nodes-base.set", "typeVersion": 3.4, "position": [200, 0], "parameters": {}}
+		]`),
+		Connections: json.RawMessage(`{"Webhook": {"main": [[{"node": "Set", "type": "main", "index": 0}]]}}`),
+		Settings:    json.RawMessage(`{}`),
+	})
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testProtoV6ProviderFactoriesForURL(t, server.URL),
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+					data "n8n_workflow" "test" {
+						id = "%s"
+					}
+				`, workflow.ID),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.n8n_workflow.test", "webhooks.#", "1"),
+					resource.TestCheckResourceAttr("data.n8n_workflow.test", "webhooks.0.node", "Webhook"),
+					resource.TestCheckResourceAttr("data.n8n_workflow.test", "webhooks.0.method", "POST"),
+					resource.TestCheckResourceAttr("data.n8n_workflow.test", "webhooks.0.path", "orders"),
+					resource.TestCheckResourceAttr("data.n8n_workflow.test", "webhooks.0.production_url", server.URL+"/webhook/orders"),
+					resource.TestCheckResourceAttr("data.n8n_workflow.test", "webhooks.0.test_url", server.URL+"/webhook-test/orders"),
+				),
+			},
+		},
+	})
+}
+
+func TestWorkflowDataSource_NotFound(t *testing.T) {
+	server := newTestServer(t)
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testProtoV6ProviderFactoriesForURL(t, server.URL),
+		Steps: []resource.TestStep{
+			{
+				Config: `
+					data "n8n_workflow" "test" {
+						id = "missing"
+					}
+				`,
+				ExpectError: regexp.MustCompile(`No workflow with ID "missing" was found`),
+			},
+		},
+	})
+}
+
+func TestWorkflowDataSource_MalformedResponse(t *testing.T) {
+	tests := map[string]struct {
+		status int
+		body   string
+		error  string
+	}{
+		"truncated JSON": {
+			status: http.StatusOK,
+			body:   `{"id": "1", "name": "Test Wor`,
+			error:  `Error retrieving workflow`,
+		},
+		"wrong types": {
+			status: http.StatusOK,
+			body:   `{"id": 1, "nodes": "none"}`,
+			error:  `cannot unmarshal`,
+		},
+		"server error": {
+			status: http.StatusInternalServerError,
+			body:   `{"message": "Internal Server Error"}`,
+			error:  `status: 500`,
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			server := newStandInServer(t, tc.status, tc.body)
+
+			resource.UnitTest(t, resource.TestCase{
+				ProtoV6ProviderFactories: testProtoV6ProviderFactoriesForURL(t, server.URL),
+				Steps: []resource.TestStep{
+					{
+						Config: `
+							data "n8n_workflow" "test" {
+								id = "1"
+							}
+						`,
+						ExpectError: regexp.MustCompile(tc.error),
+					},
+				},
+			})
+		})
+	}
+}
@@ -0,0 +1,59 @@
+// Copyright (c) Arthur Diniz <arthurbdiniz@gmail.com>
+// SPDX-License-Identifier: Apache-2.0
+
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var _ function.Function = &workflowDecodeFunction{}
+
+// NewWorkflowDecodeFunction is a helper function to simplify the provider implementation.
+func NewWorkflowDecodeFunction() function.Function {
+	return &workflowDecodeFunction{}
+}
+
+// workflowDecodeFunction is the function implementation.
+type workflowDecodeFunction struct{}
+
+// Metadata returns the function name.
+func (f *workflowDecodeFunction) Metadata(_ context.Context, _ function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "workflow_decode"
+}
+
+// Definition defines the parameters and return type of the function.
+func (f *workflowDecodeFunction) Definition(_ context.Context, _ function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary:     "Parse an n8n workflow JSON document into an object.",
+		Description: "Decodes a workflow exported from the n8n editor into an object, so nodes, connections and settings can be read and modified in HCL. Arrays are returned as tuples to preserve nodes with different parameter shapes.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:        "json",
+				Description: "The workflow JSON document, for example `file(\"workflow.json\")`.",
+			},
+		},
+		Return: function.DynamicReturn{},
+	}
+}
+
+// Run decodes the workflow JSON document.
+func (f *workflowDecodeFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var input string
+
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &input))
+	if resp.Error != nil {
+		return
+	}
+
+	workflow, err := ConvertJSONToTerraformDynamic([]byte(input))
+	if err != nil {
+		resp.Error = function.NewArgumentFuncError(0, "Invalid workflow JSON: "+err.Error())
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Result.Set(ctx, workflow))
+}
@@ -0,0 +1,81 @@
+// Copyright (c) Arthur Diniz <arthurbdiniz@gmail.com>
+// SPDX-License-Identifier: Apache-2.0
+
+package provider
+
+import (
+	"context"
+	"math/big"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func runWorkflowFunction(t *testing.T, f function.Function, result attr.Value, args ...attr.Value) function.RunResponse {
+	t.Helper()
+
+	req := function.RunRequest{
+		Arguments: function.NewArgumentsData(args),
+	}
+	resp := function.RunResponse{
+		Result: function.NewResultData(result),
+	}
+
+	f.Run(context.Background(), req, &resp)
+
+	return resp
+}
+
+func TestWorkflowDecodeFunction(t *testing.T) {
+	input := `{
+		"name": "My Workflow",
+		"nodes": [
+			{"name": "Start", "typeVersion": 1, "position": [0, 0], "parameters": {}},
+			{"name": "Set", "typeVersion": 3.4, "parameters": {"keepOnlySet": true, "values": null}}
+		],
+		"connections": {}
+	}`
+
+	resp := runWorkflowFunction(t, NewWorkflowDecodeFunction(), types.DynamicUnknown(), types.StringValue(input))
+	require.Nil(t, resp.Error)
+
+	result, ok := resp.Result.Value().(types.Dynamic)
+	require.True(t, ok)
+
+	workflow, ok := result.UnderlyingValue().(types.Object)
+	require.True(t, ok)
+	assert.Equal(t, types.StringValue("My Workflow"), workflow.Attributes()["name"])
+
+	nodes, ok := workflow.Attributes()["nodes"].(types.Tuple)
+	require.True(t, ok)
+	require.Len(t, nodes.Elements(), 2)
+
+	set, ok := nodes.Elements()[1].(types.Object)
+	require.True(t, ok)
+	assert.Equal(t, types.NumberValue(big.NewFloat(3.4)).String(), set.Attributes()["typeVersion"].String())
+
+	// The result must be serializable over the plugin protocol.
+	tfValue, err := result.ToTerraformValue(context.Background())
+	require.NoError(t, err)
+	_, err = tfprotov6.NewDynamicValue(tftypes.DynamicPseudoType, tfValue)
+	require.NoError(t, err)
+}
+
+func TestWorkflowDecodeFunction_InvalidJSON(t *testing.T) {
+	resp := runWorkflowFunction(t, NewWorkflowDecodeFunction(), types.DynamicUnknown(), types.StringValue(`{"name":`))
+	require.NotNil(t, resp.Error)
+	require.NotNil(t, resp.Error.FunctionArgument)
+	assert.Equal(t, int64(0), *resp.Error.FunctionArgument)
+}
+
+func TestWorkflowDecodeFunction_TrailingData(t *testing.T) {
+	resp := runWorkflowFunction(t, NewWorkflowDecodeFunction(), types.DynamicUnknown(), types.StringValue(`{} {}`))
+	require.NotNil(t, resp.Error)
+	assert.Contains(t, resp.Error.Text, "unexpected data")
+}
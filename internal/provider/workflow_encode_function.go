@@ -0,0 +1,66 @@
+// Copyright (c) Arthur Diniz <arthurbdiniz@gmail.com>
+// SPDX-License-Identifier: Apache-2.0
+
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var _ function.Function = &workflowEncodeFunction{}
+
+// NewWorkflowEncodeFunction is a helper function to simplify the provider implementation.
+func NewWorkflowEncodeFunction() function.Function {
+	return &workflowEncodeFunction{}
+}
+
+// workflowEncodeFunction is the function implementation.
+type workflowEncodeFunction struct{}
+
+// Metadata returns the function name.
+func (f *workflowEncodeFunction) Metadata(_ context.Context, _ function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "workflow_encode"
+}
+
+// Definition defines the parameters and return type of the function.
+func (f *workflowEncodeFunction) Definition(_ context.Context, _ function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary:     "Encode an object into canonical n8n workflow JSON.",
+		Description: "Encodes a workflow object, such as one returned by `workflow_decode`, into compact JSON with object keys sorted, so the same workflow always produces the same string.",
+		Parameters: []function.Parameter{
+			function.DynamicParameter{
+				Name:        "workflow",
+				Description: "The workflow object to encode.",
+			},
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+// Run encodes the workflow object.
+func (f *workflowEncodeFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var input types.Dynamic
+
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &input))
+	if resp.Error != nil {
+		return
+	}
+
+	workflow, err := ConvertTerraformValueToJSON(input)
+	if err != nil {
+		resp.Error = function.NewArgumentFuncError(0, "Unable to encode workflow: "+err.Error())
+		return
+	}
+
+	output, err := MarshalCanonicalJSON(workflow)
+	if err != nil {
+		resp.Error = function.NewArgumentFuncError(0, "Unable to encode workflow: "+err.Error())
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Result.Set(ctx, output))
+}
@@ -0,0 +1,73 @@
+// Copyright (c) Arthur Diniz <arthurbdiniz@gmail.com>
+// SPDX-License-Identifier: Apache-2.0
+
+package provider
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestWorkflowEncodeFunction(t *testing.T) {
+	node, diags := types.ObjectValue(
+		map[string]attr.Type{
+			"parameters":  types.MapType{ElemType: types.StringType},
+			"typeVersion": types.NumberType,
+			"name":        types.StringType,
+		},
+		map[string]attr.Value{
+			"parameters":  types.MapValueMust(types.StringType, map[string]attr.Value{"url": types.StringValue("https://example.com/?a=1&b=<2>")}),
+			"typeVersion": types.NumberValue(big.NewFloat(4.2)),
+			"name":        types.StringValue("HTTP Request"),
+		},
+	)
+	require.False(t, diags.HasError())
+
+	nodes, diags := types.TupleValue([]attr.Type{node.Type(nil)}, []attr.Value{node})
+	require.False(t, diags.HasError())
+
+	workflow, diags := types.ObjectValue(
+		map[string]attr.Type{
+			"nodes":  nodes.Type(nil),
+			"name":   types.StringType,
+			"active": types.BoolType,
+			"tags":   types.ListType{ElemType: types.StringType},
+		},
+		map[string]attr.Value{
+			"nodes":  nodes,
+			"name":   types.StringValue("My Workflow"),
+			"active": types.BoolValue(false),
+			"tags":   types.ListNull(types.StringType),
+		},
+	)
+	require.False(t, diags.HasError())
+
+	resp := runWorkflowFunction(t, NewWorkflowEncodeFunction(), types.StringUnknown(), types.DynamicValue(workflow))
+	require.Nil(t, resp.Error)
+
+	expected := `{"active":false,"name":"My Workflow","nodes":[{"name":"HTTP Request","parameters":{"url":"https://example.com/?a=1&b=<2>"},"typeVersion":4.2}],"tags":null}`
+	assert.Equal(t, types.StringValue(expected), resp.Result.Value())
+}
+
+func TestWorkflowEncodeFunction_RoundTrip(t *testing.T) {
+	input := `{"connections":{"Start":{"main":[[{"index":0,"node":"Set","type":"main"}]]}},"name":"My Workflow","nodes":[{"name":"Start","position":[250,300],"typeVersion":1}],"pinData":null}`
+
+	decoded := runWorkflowFunction(t, NewWorkflowDecodeFunction(), types.DynamicUnknown(), types.StringValue(input))
+	require.Nil(t, decoded.Error)
+
+	encoded := runWorkflowFunction(t, NewWorkflowEncodeFunction(), types.StringUnknown(), decoded.Result.Value())
+	require.Nil(t, encoded.Error)
+
+	assert.Equal(t, types.StringValue(input), encoded.Result.Value())
+}
+
+func TestWorkflowEncodeFunction_Unknown(t *testing.T) {
+	resp := runWorkflowFunction(t, NewWorkflowEncodeFunction(), types.StringUnknown(), types.DynamicValue(types.StringUnknown()))
+	require.NotNil(t, resp.Error)
+	assert.Contains(t, resp.Error.Text, "unknown")
+}
@@ -0,0 +1,254 @@
+// Copyright (c) Arthur Diniz <arthurbdiniz@gmail.com>
+// SPDX-License-Identifier: Apache-2.0
+
+package provider
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"sort"
+
+	"github.com/arthurbdiniz/terraform-provider-n8n/internal/pkg/n8n-client-go"
+)
+
+// volatileWorkflowFields are top-level workflow properties that are assigned
+// or changed by n8n itself and are therefore ignored when comparing an
+// exported workflow with the one stored on the instance.
+var volatileWorkflowFields = []string{
+	"id",
+	"versionId",
+	"createdAt",
+	"updatedAt",
+	"triggerCount",
+	"active",
+	"tags",
+	"meta",
+	"staticData",
+	"shared",
+	"isArchived",
+}
+
+// exportedWorkflowFields are the top-level workflow properties that describe
+// the workflow itself rather than its state on one n8n instance.
+var exportedWorkflowFields = []string{
+	"name",
+	"nodes",
+	"connections",
+	"settings",
+	"pinData",
+}
+
+// ExportWorkflowJSON encodes a workflow retrieved from n8n as a portable JSON
+// document, keeping only the properties in exportedWorkflowFields, with
+// object keys sorted.
+func ExportWorkflowJSON(workflow *n8n.Workflow) (string, error) {
+	data, err := json.Marshal(workflow)
+	if err != nil {
+		return "", err
+	}
+
+	var document map[string]interface{}
+	if err := json.Unmarshal(data, &document); err != nil {
+		return "", err
+	}
+
+	exported := make(map[string]interface{}, len(exportedWorkflowFields))
+	for _, field := range exportedWorkflowFields {
+		if value, ok := document[field]; ok && value != nil {
+			exported[field] = value
+		}
+	}
+
+	return MarshalCanonicalJSON(exported)
+}
+
+// ParseWorkflowJSON decodes an exported n8n workflow document.
+func ParseWorkflowJSON(input string) (*n8n.Workflow, error) {
+	var workflow n8n.Workflow
+	if err := json.Unmarshal([]byte(input), &workflow); err != nil {
+		return nil, err
+	}
+
+	if workflow.Name == "" {
+		return nil, fmt.Errorf("the workflow must have a name")
+	}
+
+	return &workflow, nil
+}
+
+// NormalizeWorkflowJSON returns the canonical JSON form of a workflow used to
+// compare exported documents with the workflow stored in n8n. The document is
+// decoded into the client model, so defaults are filled the same way on both
+// sides, volatile fields are removed and object keys are sorted. When
+// ignorePositions is set, node positions on the canvas are removed as well.
+func NormalizeWorkflowJSON(input string, ignorePositions bool) (string, error) {
+	workflow, err := ParseWorkflowJSON(input)
+	if err != nil {
+		return "", err
+	}
+
+	normalized, err := normalizeWorkflow(workflow, ignorePositions)
+	if err != nil {
+		return "", err
+	}
+
+	return MarshalCanonicalJSON(normalized)
+}
+
+func normalizeWorkflow(workflow *n8n.Workflow, ignorePositions bool) (map[string]interface{}, error) {
+	data, err := json.Marshal(workflow)
+	if err != nil {
+		return nil, err
+	}
+
+	var normalized map[string]interface{}
+	if err := json.Unmarshal(data, &normalized); err != nil {
+		return nil, err
+	}
+
+	for _, field := range volatileWorkflowFields {
+		delete(normalized, field)
+	}
+
+	// Node IDs are regenerated by the editor on copy and import, nodes
+	// are referenced by name in connections so the IDs are not compared.
+	// Credential IDs differ between instances and are resolved by name,
+	// so they are only compared for credentials without a name.
+	if nodes, ok := normalized["nodes"].([]interface{}); ok {
+		for _, node := range nodes {
+			if n, ok := node.(map[string]interface{}); ok {
+				delete(n, "id")
+				if ignorePositions {
+					delete(n, "position")
+				}
+				removeCredentialIDs(n)
+			}
+		}
+	}
+
+	removeEmptyJSONValues(normalized)
+
+	return normalized, nil
+}
+
+// removeCredentialIDs removes the ID of the named credentials of a decoded node.
+func removeCredentialIDs(node map[string]interface{}) {
+	credentials, ok := node["credentials"].(map[string]interface{})
+	if !ok {
+		return
+	}
+	for _, credential := range credentials {
+		if ref, ok := credential.(map[string]interface{}); ok {
+			if name, _ := ref["name"].(string); name != "" {
+				delete(ref, "id")
+			}
+		}
+	}
+}
+
+// NormalizeJSONDocument returns the canonical JSON form of a document used for
+// semantic comparison. Workflow documents, recognized by their nodes array,
+// are normalized with NormalizeWorkflowJSON. Other documents, such as
+// connections, only have their keys sorted and empty values removed.
+func NormalizeJSONDocument(input string) (string, error) {
+	var document interface{}
+	if err := json.Unmarshal([]byte(input), &document); err != nil {
+		return "", err
+	}
+
+	if object, ok := document.(map[string]interface{}); ok {
+		if _, ok := object["nodes"]; ok {
+			return NormalizeWorkflowJSON(input, false)
+		}
+	}
+
+	removeEmptyJSONValues(document)
+
+	return MarshalCanonicalJSON(document)
+}
+
+// removeEmptyJSONValues recursively removes object members that are null,
+// empty objects or empty arrays. n8n adds such members as defaults, for
+// example an empty options object on most nodes, so they carry no meaning.
+func removeEmptyJSONValues(value interface{}) {
+	switch val := value.(type) {
+	case map[string]interface{}:
+		for k, item := range val {
+			removeEmptyJSONValues(item)
+			if isEmptyJSONValue(item) {
+				delete(val, k)
+			}
+		}
+	case []interface{}:
+		for _, item := range val {
+			removeEmptyJSONValues(item)
+		}
+	}
+}
+
+func isEmptyJSONValue(value interface{}) bool {
+	switch val := value.(type) {
+	case nil:
+		return true
+	case map[string]interface{}:
+		return len(val) == 0
+	case []interface{}:
+		return len(val) == 0
+	}
+	return false
+}
+
+// WorkflowNodeDrift compares the nodes of two normalized workflow documents by
+// name and returns a human-readable description of every node that was added,
+// removed or changed in the actual workflow.
+func WorkflowNodeDrift(expected, actual string) ([]string, error) {
+	expectedNodes, err := workflowNodesByName(expected)
+	if err != nil {
+		return nil, err
+	}
+
+	actualNodes, err := workflowNodesByName(actual)
+	if err != nil {
+		return nil, err
+	}
+
+	var drift []string
+	for name, node := range expectedNodes {
+		actualNode, ok := actualNodes[name]
+		if !ok {
+			drift = append(drift, fmt.Sprintf("node %q was removed", name))
+			continue
+		}
+		if !reflect.DeepEqual(node, actualNode) {
+			drift = append(drift, fmt.Sprintf("node %q was changed", name))
+		}
+	}
+
+	for name := range actualNodes {
+		if _, ok := expectedNodes[name]; !ok {
+			drift = append(drift, fmt.Sprintf("node %q was added", name))
+		}
+	}
+
+	sort.Strings(drift)
+
+	return drift, nil
+}
+
+func workflowNodesByName(input string) (map[string]interface{}, error) {
+	var workflow struct {
+		Nodes []map[string]interface{} `json:"nodes"`
+	}
+	if err := json.Unmarshal([]byte(input), &workflow); err != nil {
+		return nil, err
+	}
+
+	nodes := make(map[string]interface{}, len(workflow.Nodes))
+	for _, node := range workflow.Nodes {
+		name, _ := node["name"].(string)
+		nodes[name] = node
+	}
+
+	return nodes, nil
+}
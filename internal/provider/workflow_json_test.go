@@ -0,0 +1,150 @@
+// Copyright (c) Arthur Diniz <arthurbdiniz@gmail.com>
+// SPDX-License-Identifier: Apache-2.0
+
+package provider
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const exportedWorkflowJSON = `{
+	"name": "My Workflow",
+	"nodes": [
+		{
+			"parameters": {},
+			"id": "8c1c9c26-5c4f-4a0a-9b1c-0f3a4c1b2d3e",
+			"name": "Start",
+			"type": "n8n-nodes-base.manualTrigger",
+			"typeVersion": 1,
+			"position": [0, 0]
+		},
+		{
+			"parameters": {"url": "https://example.com", "options": {}},
+			"id": "2f0b7a52-1d2b-4c8e-8a3d-9e6f5a4b3c2d",
+			"name": "HTTP Request",
+			"type": "n8n-nodes-base.httpRequest",
+			"typeVersion": 4.2,
+			"position": [220, 0],
+			"credentials": {"httpHeaderAuth": {"id": "1", "name": "Header Auth"}}
+		}
+	],
+	"pinData": {},
+	"connections": {
+		"Start": {"main": [[{"node": "HTTP Request", "type": "main", "index": 0}]]}
+	},
+	"active": true,
+	"settings": {"executionOrder": "v1"},
+	"versionId": "6f3b4e1a-5c2d-4b7a-8e9f-0a1b2c3d4e5f",
+	"meta": {"instanceId": "abc"},
+	"id": "3LODqkaWPmYOi0FA",
+	"tags": []
+}`
+
+func TestNormalizeWorkflowJSON_IgnoresVolatileFieldsAndFormatting(t *testing.T) {
+	reordered := `{"settings":{"executionOrder":"v1"},"connections":{"Start":{"main":[[{"index":0,"type":"main","node":"HTTP Request"}]]}},
+		"nodes":[{"name":"Start","type":"n8n-nodes-base.manualTrigger","typeVersion":1,"position":[0,0],"parameters":{},"id":"8c1c9c26-5c4f-4a0a-9b1c-0f3a4c1b2d3e"},
+		{"credentials":{"httpHeaderAuth":{"name":"Header Auth","id":"1"}},"name":"HTTP Request","type":"n8n-nodes-base.httpRequest","typeVersion":4.2,"position":[220,0],
+		"parameters":{"options":{},"url":"https://example.com"},"id":"2f0b7a52-1d2b-4c8e-8a3d-9e6f5a4b3c2d"}],"name":"My Workflow"}`
+
+	expected, err := NormalizeWorkflowJSON(exportedWorkflowJSON, false)
+	require.NoError(t, err)
+
+	actual, err := NormalizeWorkflowJSON(reordered, false)
+	require.NoError(t, err)
+
+	assert.Equal(t, expected, actual)
+	assert.NotContains(t, actual, "versionId")
+	assert.NotContains(t, actual, "3LODqkaWPmYOi0FA")
+	assert.Contains(t, actual, `"credentials":{"httpHeaderAuth":{"name":"Header Auth"}}`)
+}
+
+func TestExportWorkflowJSON(t *testing.T) {
+	workflow, err := ParseWorkflowJSON(exportedWorkflowJSON)
+	require.NoError(t, err)
+
+	exported, err := ExportWorkflowJSON(workflow)
+	require.NoError(t, err)
+
+	assert.NotContains(t, exported, "versionId")
+	assert.NotContains(t, exported, "3LODqkaWPmYOi0FA")
+	assert.NotContains(t, exported, `"active"`)
+	assert.Contains(t, exported, `"credentials":{"httpHeaderAuth":{"id":"1","name":"Header Auth"}}`)
+
+	// The export can be applied as is, and is stable.
+	reparsed, err := ParseWorkflowJSON(exported)
+	require.NoError(t, err)
+	reexported, err := ExportWorkflowJSON(reparsed)
+	require.NoError(t, err)
+	assert.Equal(t, exported, reexported)
+}
+
+func TestNormalizeWorkflowJSON_CredentialIDs(t *testing.T) {
+	dev := `{"name":"My Workflow","nodes":[{"name":"HTTP Request","credentials":{"httpHeaderAuth":{"id":"1","name":"Header Auth"}}}]}`
+	prod := `{"name":"My Workflow","nodes":[{"name":"HTTP Request","credentials":{"httpHeaderAuth":{"id":"42","name":"Header Auth"}}}]}`
+	renamed := `{"name":"My Workflow","nodes":[{"name":"HTTP Request","credentials":{"httpHeaderAuth":{"id":"42","name":"Other Auth"}}}]}`
+	unnamed := `{"name":"My Workflow","nodes":[{"name":"HTTP Request","credentials":{"httpHeaderAuth":{"id":"42"}}}]}`
+
+	devNormalized, err := NormalizeWorkflowJSON(dev, false)
+	require.NoError(t, err)
+	prodNormalized, err := NormalizeWorkflowJSON(prod, false)
+	require.NoError(t, err)
+	renamedNormalized, err := NormalizeWorkflowJSON(renamed, false)
+	require.NoError(t, err)
+	unnamedNormalized, err := NormalizeWorkflowJSON(unnamed, false)
+	require.NoError(t, err)
+
+	assert.Equal(t, devNormalized, prodNormalized, "credential IDs are instance specific")
+	assert.NotEqual(t, prodNormalized, renamedNormalized)
+	assert.Contains(t, unnamedNormalized, `"id":"42"`, "credentials without a name keep their ID")
+}
+
+func TestNormalizeWorkflowJSON_Positions(t *testing.T) {
+	moved := `{"name":"My Workflow","nodes":[{"name":"Start","position":[100,200]}]}`
+	original := `{"name":"My Workflow","nodes":[{"name":"Start","position":[0,0]}]}`
+
+	movedNormalized, err := NormalizeWorkflowJSON(moved, false)
+	require.NoError(t, err)
+	originalNormalized, err := NormalizeWorkflowJSON(original, false)
+	require.NoError(t, err)
+	assert.NotEqual(t, originalNormalized, movedNormalized)
+
+	fractional, err := NormalizeWorkflowJSON(`{"name":"My Workflow","nodes":[{"name":"Start","position":[100.0,200.5]}]}`, false)
+	require.NoError(t, err)
+	assert.Contains(t, fractional, `"position":[100,200.5]`)
+
+	movedNormalized, err = NormalizeWorkflowJSON(moved, true)
+	require.NoError(t, err)
+	originalNormalized, err = NormalizeWorkflowJSON(original, true)
+	require.NoError(t, err)
+	assert.Equal(t, originalNormalized, movedNormalized)
+}
+
+func TestNormalizeWorkflowJSON_Invalid(t *testing.T) {
+	_, err := NormalizeWorkflowJSON(`{"name":`, false)
+	assert.Error(t, err)
+
+	_, err = NormalizeWorkflowJSON(`{"nodes":[]}`, false)
+	assert.ErrorContains(t, err, "name")
+}
+
+func TestWorkflowNodeDrift(t *testing.T) {
+	expected := `{"nodes":[{"name":"Start"},{"name":"Set","parameters":{"a":1}},{"name":"Removed"}]}`
+	actual := `{"nodes":[{"name":"Start"},{"name":"Set","parameters":{"a":2}},{"name":"Added"}]}`
+
+	drift, err := WorkflowNodeDrift(expected, actual)
+	require.NoError(t, err)
+	assert.Equal(t, []string{
+		`node "Added" was added`,
+		`node "Removed" was removed`,
+		`node "Set" was changed`,
+	}, drift)
+}
+
+func TestWorkflowNodeDrift_NoChanges(t *testing.T) {
+	drift, err := WorkflowNodeDrift(`{"nodes":[{"name":"Start"}]}`, `{"nodes":[{"name":"Start"}]}`)
+	require.NoError(t, err)
+	assert.Empty(t, drift)
+}
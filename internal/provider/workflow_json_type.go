@@ -0,0 +1,158 @@
+// Copyright (c) Arthur Diniz <arthurbdiniz@gmail.com>
+// SPDX-License-Identifier: Apache-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/attr/xattr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ basetypes.StringTypable                    = WorkflowJSONType{}
+	_ basetypes.StringValuableWithSemanticEquals = WorkflowJSONValue{}
+	_ xattr.ValidateableAttribute                = WorkflowJSONValue{}
+)
+
+// WorkflowJSONType is a string type for n8n workflow JSON documents, and
+// fragments of them such as connections, that compares values semantically.
+type WorkflowJSONType struct {
+	basetypes.StringType
+}
+
+// String returns a human readable string of the type name.
+func (t WorkflowJSONType) String() string {
+	return "provider.WorkflowJSONType"
+}
+
+// ValueType returns the Value type.
+func (t WorkflowJSONType) ValueType(_ context.Context) attr.Value {
+	return WorkflowJSONValue{}
+}
+
+// Equal returns true if the given type is equivalent.
+func (t WorkflowJSONType) Equal(o attr.Type) bool {
+	other, ok := o.(WorkflowJSONType)
+	if !ok {
+		return false
+	}
+
+	return t.StringType.Equal(other.StringType)
+}
+
+// ValueFromString returns a StringValuable type given a StringValue.
+func (t WorkflowJSONType) ValueFromString(_ context.Context, in basetypes.StringValue) (basetypes.StringValuable, diag.Diagnostics) {
+	return WorkflowJSONValue{StringValue: in}, nil
+}
+
+// ValueFromTerraform returns a Value given a tftypes.Value.
+func (t WorkflowJSONType) ValueFromTerraform(ctx context.Context, in tftypes.Value) (attr.Value, error) {
+	attrValue, err := t.StringType.ValueFromTerraform(ctx, in)
+	if err != nil {
+		return nil, err
+	}
+
+	stringValue, ok := attrValue.(basetypes.StringValue)
+	if !ok {
+		return nil, fmt.Errorf("unexpected value type of %T", attrValue)
+	}
+
+	stringValuable, diags := t.ValueFromString(ctx, stringValue)
+	if diags.HasError() {
+		return nil, fmt.Errorf("unexpected error converting StringValue to StringValuable: %v", diags)
+	}
+
+	return stringValuable, nil
+}
+
+// WorkflowJSONValue is a workflow JSON document. Two values are semantically
+// equal when they describe the same workflow, regardless of key order,
+// formatting, volatile fields assigned by n8n, node IDs regenerated by the
+// editor or empty defaults added by the server.
+type WorkflowJSONValue struct {
+	basetypes.StringValue
+}
+
+// NewWorkflowJSONValue creates a WorkflowJSONValue with a known value.
+func NewWorkflowJSONValue(value string) WorkflowJSONValue {
+	return WorkflowJSONValue{StringValue: basetypes.NewStringValue(value)}
+}
+
+// NewWorkflowJSONNull creates a WorkflowJSONValue with a null value.
+func NewWorkflowJSONNull() WorkflowJSONValue {
+	return WorkflowJSONValue{StringValue: basetypes.NewStringNull()}
+}
+
+// Type returns a WorkflowJSONType.
+func (v WorkflowJSONValue) Type(_ context.Context) attr.Type {
+	return WorkflowJSONType{}
+}
+
+// Equal returns true if the given value is equivalent.
+func (v WorkflowJSONValue) Equal(o attr.Value) bool {
+	other, ok := o.(WorkflowJSONValue)
+	if !ok {
+		return false
+	}
+
+	return v.StringValue.Equal(other.StringValue)
+}
+
+// StringSemanticEquals returns true if the given value describes the same
+// workflow document as the current value.
+func (v WorkflowJSONValue) StringSemanticEquals(_ context.Context, newValuable basetypes.StringValuable) (bool, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	newValue, ok := newValuable.(WorkflowJSONValue)
+	if !ok {
+		diags.AddError(
+			"Semantic Equality Check Error",
+			"An unexpected value type was received while performing semantic equality checks. "+
+				"Please report this to the provider developers.\n\n"+
+				"Expected Value Type: "+fmt.Sprintf("%T", v)+"\n"+
+				"Got Value Type: "+fmt.Sprintf("%T", newValuable),
+		)
+
+		return false, diags
+	}
+
+	if v.IsNull() || v.IsUnknown() || newValue.IsNull() || newValue.IsUnknown() {
+		return false, diags
+	}
+
+	prior, err := NormalizeJSONDocument(v.ValueString())
+	if err != nil {
+		return false, diags
+	}
+
+	proposed, err := NormalizeJSONDocument(newValue.ValueString())
+	if err != nil {
+		return false, diags
+	}
+
+	return prior == proposed, diags
+}
+
+// ValidateAttribute ensures the value is a valid JSON document.
+func (v WorkflowJSONValue) ValidateAttribute(_ context.Context, req xattr.ValidateAttributeRequest, resp *xattr.ValidateAttributeResponse) {
+	if v.IsNull() || v.IsUnknown() {
+		return
+	}
+
+	if _, err := NormalizeJSONDocument(v.ValueString()); err != nil {
+		resp.Diagnostics.AddAttributeError(
+			req.Path,
+			"Invalid Workflow JSON",
+			"A string value was provided that is not a valid workflow JSON document.\n\n"+
+				"Given Value: "+v.ValueString()+"\n"+
+				"Error: "+err.Error(),
+		)
+	}
+}
@@ -0,0 +1,106 @@
+// Copyright (c) Arthur Diniz <arthurbdiniz@gmail.com>
+// SPDX-License-Identifier: Apache-2.0
+
+package provider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr/xattr"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestWorkflowJSONValue_StringSemanticEquals(t *testing.T) {
+	testCases := map[string]struct {
+		prior    string
+		proposed string
+		expected bool
+	}{
+		"key order and whitespace": {
+			prior:    `{"name":"My Workflow","nodes":[{"name":"Start","parameters":{"a":1,"b":2}}]}`,
+			proposed: "{\n  \"nodes\": [{\"parameters\": {\"b\": 2, \"a\": 1}, \"name\": \"Start\"}],\n  \"name\": \"My Workflow\"\n}",
+			expected: true,
+		},
+		"regenerated node IDs": {
+			prior:    `{"name":"My Workflow","nodes":[{"id":"1","name":"Start"}]}`,
+			proposed: `{"name":"My Workflow","nodes":[{"id":"d6a1e8a0-7c1e-4f43-9a57-0f1b1b3f8c11","name":"Start"}]}`,
+			expected: true,
+		},
+		"server-added defaults": {
+			prior:    `{"name":"My Workflow","nodes":[{"name":"Start","parameters":{"url":"https://example.com"}}]}`,
+			proposed: `{"name":"My Workflow","nodes":[{"name":"Start","parameters":{"url":"https://example.com","options":{}}}],"pinData":{},"settings":{"executionOrder":""},"active":false,"versionId":"abc","tags":[]}`,
+			expected: true,
+		},
+		"changed parameter": {
+			prior:    `{"name":"My Workflow","nodes":[{"name":"Start","parameters":{"url":"https://example.com"}}]}`,
+			proposed: `{"name":"My Workflow","nodes":[{"name":"Start","parameters":{"url":"https://example.org"}}]}`,
+			expected: false,
+		},
+		"moved node": {
+			prior:    `{"name":"My Workflow","nodes":[{"name":"Start","position":[0,0]}]}`,
+			proposed: `{"name":"My Workflow","nodes":[{"name":"Start","position":[100,0]}]}`,
+			expected: false,
+		},
+		"connections key order": {
+			prior:    `{"Start":{"main":[[{"node":"Set","type":"main","index":0}]]}}`,
+			proposed: `{"Start":{"main":[[{"index":0,"type":"main","node":"Set"}]]}}`,
+			expected: true,
+		},
+		"connections target": {
+			prior:    `{"Start":{"main":[[{"node":"Set","type":"main","index":0}]]}}`,
+			proposed: `{"Start":{"main":[[{"node":"Code","type":"main","index":0}]]}}`,
+			expected: false,
+		},
+		"invalid JSON": {
+			prior:    `{}`,
+			proposed: `{`,
+			expected: false,
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			equal, diags := NewWorkflowJSONValue(testCase.prior).StringSemanticEquals(context.Background(), NewWorkflowJSONValue(testCase.proposed))
+			require.False(t, diags.HasError())
+			assert.Equal(t, testCase.expected, equal)
+		})
+	}
+}
+
+func TestWorkflowJSONValue_StringSemanticEquals_UnexpectedType(t *testing.T) {
+	_, diags := NewWorkflowJSONValue(`{}`).StringSemanticEquals(context.Background(), basetypes.NewStringValue(`{}`))
+	assert.True(t, diags.HasError())
+}
+
+func TestWorkflowJSONValue_ValidateAttribute(t *testing.T) {
+	testCases := map[string]struct {
+		value       WorkflowJSONValue
+		expectError bool
+	}{
+		"valid":          {value: NewWorkflowJSONValue(`{"name":"My Workflow","nodes":[]}`)},
+		"valid fragment": {value: NewWorkflowJSONValue(`{"Start":{"main":[]}}`)},
+		"null":           {value: NewWorkflowJSONNull()},
+		"invalid":        {value: NewWorkflowJSONValue(`{"name":`), expectError: true},
+		"missing name":   {value: NewWorkflowJSONValue(`{"nodes":[]}`), expectError: true},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			resp := xattr.ValidateAttributeResponse{}
+			testCase.value.ValidateAttribute(context.Background(), xattr.ValidateAttributeRequest{Path: path.Root("workflow_json")}, &resp)
+			assert.Equal(t, testCase.expectError, resp.Diagnostics.HasError())
+		})
+	}
+}
+
+func TestWorkflowJSONType_ValueFromTerraform(t *testing.T) {
+	value, err := WorkflowJSONType{}.ValueFromTerraform(context.Background(), tftypes.NewValue(tftypes.String, `{"name":"My Workflow"}`))
+	require.NoError(t, err)
+	assert.Equal(t, NewWorkflowJSONValue(`{"name":"My Workflow"}`), value)
+	assert.True(t, WorkflowJSONType{}.Equal(value.Type(context.Background())))
+}
@@ -0,0 +1,215 @@
+// Copyright (c) Arthur Diniz <arthurbdiniz@gmail.com>
+// SPDX-License-Identifier: Apache-2.0
+
+package provider
+
+import (
+	"fmt"
+
+	"github.com/arthurbdiniz/terraform-provider-n8n/internal/pkg/n8n-client-go"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// workflowModel maps the workflow attributes shared by the data sources.
+type workflowModel struct {
+	ID           types.String      `tfsdk:"id"`
+	Name         types.String      `tfsdk:"name"`
+	Active       types.Bool        `tfsdk:"active"`
+	VersionId    types.String      `tfsdk:"version_id"`
+	TriggerCount types.Int64       `tfsdk:"trigger_count"`
+	CreatedAt    types.String      `tfsdk:"created_at"`
+	UpdatedAt    types.String      `tfsdk:"updated_at"`
+	Nodes        []nodesModel      `tfsdk:"nodes"`
+	Connections  WorkflowJSONValue `tfsdk:"connections"`
+	Settings     *settingsModel    `tfsdk:"settings"`
+	Tags         []tagsModel       `tfsdk:"tags"`
+	PinData      types.String      `tfsdk:"pin_data"`
+	StaticData   types.String      `tfsdk:"static_data"`
+	Meta         types.String      `tfsdk:"meta"`
+	WorkflowJSON WorkflowJSONValue `tfsdk:"workflow_json"`
+	Webhooks     []webhookModel    `tfsdk:"webhooks"`
+}
+
+type nodesModel struct {
+	ID             types.String     `tfsdk:"id"`
+	Name           types.String     `tfsdk:"name"`
+	Type           types.String     `tfsdk:"type"`
+	TypeVersion    types.Float64    `tfsdk:"type_version"`
+	Position       []types.Float64  `tfsdk:"position"`
+	Parameters     []parameterModel `tfsdk:"parameters"`
+	ParametersJSON types.String     `tfsdk:"parameters_json"`
+}
+
+type webhookModel struct {
+	Node          types.String `tfsdk:"node"`
+	Method        types.String `tfsdk:"method"`
+	Path          types.String `tfsdk:"path"`
+	ProductionURL types.String `tfsdk:"production_url"`
+	TestURL       types.String `tfsdk:"test_url"`
+}
+
+type tagsModel struct {
+	CreatedAt types.String `tfsdk:"created_at"`
+	UpdatedAt types.String `tfsdk:"updated_at"`
+	ID        types.String `tfsdk:"id"`
+	Name      types.String `tfsdk:"name"`
+}
+
+type parameterModel struct {
+	Key   types.String `tfsdk:"key"`
+	Type  types.String `tfsdk:"type"`
+	Value types.String `tfsdk:"value"`
+}
+
+type settingsModel struct {
+	SaveExecutionProgress    types.Bool   `tfsdk:"save_execution_progress"`
+	SaveManualExecutions     types.Bool   `tfsdk:"save_manual_executions"`
+	SaveDataErrorExecution   types.String `tfsdk:"save_data_error_execution"`
+	SaveDataSuccessExecution types.String `tfsdk:"save_data_success_execution"`
+	ExecutionTimeout         types.Int64  `tfsdk:"execution_timeout"`
+	ErrorWorkflow            types.String `tfsdk:"error_workflow"`
+	Timezone                 types.String `tfsdk:"timezone"`
+	ExecutionOrder           types.String `tfsdk:"execution_order"`
+}
+
+// newWorkflowModel converts an n8n workflow into its Terraform model, with
+// the webhook URLs below hostURL. Conversion problems are reported as
+// diagnostics against attributes under base, so callers can point at the
+// exact workflow and node that failed.
+func newWorkflowModel(base path.Path, workflow *n8n.Workflow, hostURL string) (workflowModel, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	model := workflowModel{
+		ID:           types.StringValue(workflow.ID),
+		Name:         types.StringValue(workflow.Name),
+		Active:       types.BoolValue(workflow.Active),
+		VersionId:    types.StringValue(workflow.VersionId),
+		TriggerCount: types.Int64Value(int64(workflow.TriggerCount)),
+		CreatedAt:    types.StringValue(workflow.CreatedAt),
+		UpdatedAt:    types.StringValue(workflow.UpdatedAt),
+		Settings:     newSettingsModel(workflow.Settings),
+		Tags:         newTagsModel(workflow.Tags),
+	}
+
+	for i, node := range workflow.Nodes {
+		nodeModel, nodeDiags := newNodeModel(base.AtName("nodes").AtListIndex(i), node)
+		diags.Append(nodeDiags...)
+		model.Nodes = append(model.Nodes, nodeModel)
+	}
+
+	connectionsJSON, err := ConvertConnectionsToTerraformMap(workflow.Connections)
+	if err != nil {
+		diags.AddAttributeError(base.AtName("connections"), "Unable to Convert Workflow Connections", err.Error())
+	}
+	model.Connections = WorkflowJSONValue{StringValue: connectionsJSON}
+
+	model.PinData = convertRawJSONAttribute(base.AtName("pin_data"), "pin data", workflow.PinData, &diags)
+	model.StaticData = convertRawJSONAttribute(base.AtName("static_data"), "static data", workflow.StaticData, &diags)
+	model.Meta = convertRawJSONAttribute(base.AtName("meta"), "meta", workflow.Meta, &diags)
+	model.Webhooks = newWebhooksModel(workflowWebhooks(hostURL, workflow))
+
+	// The export fails for the same reasons as the conversions above, which
+	// already reported them.
+	if diags.HasError() {
+		model.WorkflowJSON = NewWorkflowJSONNull()
+		return model, diags
+	}
+
+	workflowJSON, err := ExportWorkflowJSON(workflow)
+	if err != nil {
+		diags.AddAttributeError(base.AtName("workflow_json"), "Unable to Export Workflow", err.Error())
+	}
+	model.WorkflowJSON = NewWorkflowJSONValue(workflowJSON)
+
+	return model, diags
+}
+
+// newWebhooksModel converts the webhooks registered by a workflow.
+func newWebhooksModel(webhooks []workflowWebhook) []webhookModel {
+	models := make([]webhookModel, 0, len(webhooks))
+	for _, webhook := range webhooks {
+		models = append(models, webhookModel{
+			Node:          types.StringValue(webhook.Node),
+			Method:        types.StringValue(webhook.Method),
+			Path:          types.StringValue(webhook.Path),
+			ProductionURL: types.StringValue(webhook.ProductionURL),
+			TestURL:       types.StringValue(webhook.TestURL),
+		})
+	}
+	return models
+}
+
+// newNodeModel converts a single workflow node, reporting parameter
+// conversion problems against the node rather than dropping it.
+func newNodeModel(base path.Path, node n8n.Node) (nodesModel, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	var positions []types.Float64
+	for _, p := range node.Position {
+		positions = append(positions, types.Float64Value(p))
+	}
+
+	parameters, err := ConvertToTerraformList(node.Parameters)
+	if err != nil {
+		diags.AddAttributeError(
+			base.AtName("parameters"),
+			"Unable to Convert Node Parameters",
+			fmt.Sprintf("Parameters of node %q could not be converted: %s", node.Name, err),
+		)
+	}
+
+	parametersJSON, err := ConvertParametersToTerraformString(node.Parameters)
+	if err != nil {
+		diags.AddAttributeError(
+			base.AtName("parameters_json"),
+			"Unable to Convert Node Parameters",
+			fmt.Sprintf("Parameters of node %q could not be encoded as JSON: %s", node.Name, err),
+		)
+	}
+
+	return nodesModel{
+		ID:             types.StringValue(node.ID),
+		Name:           types.StringValue(node.Name),
+		Type:           types.StringValue(node.Type),
+		TypeVersion:    types.Float64Value(node.TypeVersion),
+		Position:       positions,
+		Parameters:     parameters,
+		ParametersJSON: parametersJSON,
+	}, diags
+}
+
+func newTagsModel(tags []n8n.Tag) []tagsModel {
+	var models []tagsModel
+	for _, tag := range tags {
+		models = append(models, tagsModel{
+			CreatedAt: types.StringValue(tag.CreatedAt),
+			UpdatedAt: types.StringValue(tag.UpdatedAt),
+			ID:        types.StringValue(tag.ID),
+			Name:      types.StringValue(tag.Name),
+		})
+	}
+	return models
+}
+
+func newSettingsModel(settings n8n.Settings) *settingsModel {
+	return &settingsModel{
+		SaveExecutionProgress:    types.BoolValue(settings.SaveExecutionProgress),
+		SaveManualExecutions:     types.BoolValue(settings.SaveManualExecutions),
+		SaveDataErrorExecution:   types.StringValue(settings.SaveDataErrorExecution),
+		SaveDataSuccessExecution: types.StringValue(settings.SaveDataSuccessExecution),
+		ExecutionTimeout:         types.Int64Value(int64(settings.ExecutionTimeout)),
+		ErrorWorkflow:            types.StringValue(settings.ErrorWorkflow),
+		Timezone:                 types.StringValue(settings.Timezone),
+		ExecutionOrder:           types.StringValue(settings.ExecutionOrder),
+	}
+}
+
+func convertRawJSONAttribute(p path.Path, name string, raw []byte, diags *diag.Diagnostics) types.String {
+	value, err := ConvertRawJSONToTerraformString(raw)
+	if err != nil {
+		diags.AddAttributeError(p, "Unable to Convert Workflow JSON", fmt.Sprintf("The workflow %s is not valid JSON: %s", name, err))
+	}
+	return value
+}
@@ -0,0 +1,115 @@
+// Copyright (c) Arthur Diniz <arthurbdiniz@gmail.com>
+// SPDX-License-Identifier: Apache-2.0
+
+package provider
+
+import (
+	"encoding/json"
+	"math"
+	"testing"
+
+	"github.com/arthurbdiniz/terraform-provider-n8n/internal/pkg/n8n-client-go"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewWorkflowModel(t *testing.T) {
+	workflow := &n8n.Workflow{
+		ID:           "wf1",
+		Name:         "Example",
+		Active:       true,
+		VersionId:    "v1",
+		TriggerCount: 2,
+		CreatedAt:    "2024-01-01T00:00:00.000Z",
+		UpdatedAt:    "2024-01-02T00:00:00.000Z",
+		Nodes: []n8n.Node{
+			{
+				ID:          "n1",
+				Name:        "Start",
+				Type:        "n8n-nodes-base.manualTrigger",
+				TypeVersion: 1.1,
+				Position:    []float64{100, 200},
+				Parameters:  map[string]interface{}{"url": "https://example.com"},
+			},
+		},
+		Connections: map[string]n8n.Connection{
+			"Start": {Main: json.RawMessage(`[[{"node":"End","type":"main","index":0}]]`)},
+		},
+		Settings: n8n.Settings{
+			ExecutionOrder:   "v1",
+			ExecutionTimeout: 60,
+		},
+		Tags:    []n8n.Tag{{ID: "t1", Name: "prod"}},
+		PinData: json.RawMessage(`{ "Start": [] }`),
+	}
+
+	model, diags := newWorkflowModel(path.Empty(), workflow, "https://n8n.example.com")
+	require.False(t, diags.HasError(), diags)
+
+	assert.Equal(t, types.StringValue("wf1"), model.ID)
+	assert.Equal(t, types.StringValue("Example"), model.Name)
+	assert.Equal(t, types.BoolValue(true), model.Active)
+	assert.Equal(t, types.Int64Value(2), model.TriggerCount)
+
+	require.Len(t, model.Nodes, 1)
+	assert.Equal(t, types.StringValue("Start"), model.Nodes[0].Name)
+	assert.Equal(t, types.Float64Value(1.1), model.Nodes[0].TypeVersion)
+	assert.Equal(t, []types.Float64{types.Float64Value(100), types.Float64Value(200)}, model.Nodes[0].Position)
+	assert.Equal(t, `{"url":"https://example.com"}`, model.Nodes[0].ParametersJSON.ValueString())
+	require.Len(t, model.Nodes[0].Parameters, 1)
+	assert.Equal(t, types.StringValue("url"), model.Nodes[0].Parameters[0].Key)
+
+	assert.JSONEq(t, `{"Start":{"main":[[{"node":"End","type":"main","index":0}]]}}`, model.Connections.ValueString())
+
+	require.NotNil(t, model.Settings)
+	assert.Equal(t, types.StringValue("v1"), model.Settings.ExecutionOrder)
+	assert.Equal(t, types.Int64Value(60), model.Settings.ExecutionTimeout)
+
+	require.Len(t, model.Tags, 1)
+	assert.Equal(t, types.StringValue("prod"), model.Tags[0].Name)
+
+	assert.Equal(t, types.StringValue(`{"Start":[]}`), model.PinData)
+	assert.True(t, model.StaticData.IsNull())
+	assert.True(t, model.Meta.IsNull())
+}
+
+func TestNewWorkflowModel_NodeErrorIsReportedNotSkipped(t *testing.T) {
+	workflow := &n8n.Workflow{
+		ID:   "wf1",
+		Name: "Example",
+		Nodes: []n8n.Node{
+			{Name: "Good", Parameters: map[string]interface{}{}},
+			{Name: "Bad", Parameters: map[string]interface{}{"value": math.Inf(1)}},
+		},
+	}
+
+	base := path.Root("workflows").AtListIndex(3)
+	model, diags := newWorkflowModel(base, workflow, "https://n8n.example.com")
+
+	require.True(t, diags.HasError())
+	assert.Len(t, model.Nodes, 2)
+
+	errs := diags.Errors()
+	require.Len(t, errs, 1)
+	assert.Equal(t, "Unable to Convert Node Parameters", errs[0].Summary())
+	assert.Contains(t, errs[0].Detail(), `"Bad"`)
+
+	withPath, ok := errs[0].(interface{ Path() path.Path })
+	require.True(t, ok)
+	assert.True(t, withPath.Path().Equal(base.AtName("nodes").AtListIndex(1).AtName("parameters_json")))
+}
+
+func TestNewWorkflowModel_InvalidRawJSON(t *testing.T) {
+	workflow := &n8n.Workflow{
+		ID:         "wf1",
+		StaticData: json.RawMessage(`{invalid`),
+	}
+
+	model, diags := newWorkflowModel(path.Empty(), workflow, "https://n8n.example.com")
+
+	require.True(t, diags.HasError())
+	assert.Equal(t, "Unable to Convert Workflow JSON", diags.Errors()[0].Summary())
+	assert.True(t, model.StaticData.IsNull())
+}
@@ -0,0 +1,90 @@
+// Copyright (c) Arthur Diniz <arthurbdiniz@gmail.com>
+// SPDX-License-Identifier: Apache-2.0
+
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var _ function.Function = &workflowNodeFunction{}
+
+// NewWorkflowNodeFunction is a helper function to simplify the provider implementation.
+func NewWorkflowNodeFunction() function.Function {
+	return &workflowNodeFunction{}
+}
+
+// workflowNodeFunction is the function implementation.
+type workflowNodeFunction struct{}
+
+// Metadata returns the function name.
+func (f *workflowNodeFunction) Metadata(_ context.Context, _ function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "workflow_node"
+}
+
+// Definition defines the parameters and return type of the function.
+func (f *workflowNodeFunction) Definition(_ context.Context, _ function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary:     "Extract a single node from an n8n workflow JSON document.",
+		Description: "Returns the node with the given name from a workflow exported from the n8n editor, decoded into an object.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:        "json",
+				Description: "The workflow JSON document, for example `file(\"workflow.json\")`.",
+			},
+			function.StringParameter{
+				Name:        "name",
+				Description: "The name of the node to extract.",
+			},
+		},
+		Return: function.DynamicReturn{},
+	}
+}
+
+// Run extracts the node from the workflow JSON document.
+func (f *workflowNodeFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var input, name string
+
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &input, &name))
+	if resp.Error != nil {
+		return
+	}
+
+	var workflow struct {
+		Nodes []json.RawMessage `json:"nodes"`
+	}
+	if err := json.Unmarshal([]byte(input), &workflow); err != nil {
+		resp.Error = function.NewArgumentFuncError(0, "Invalid workflow JSON: "+err.Error())
+		return
+	}
+
+	for _, rawNode := range workflow.Nodes {
+		var node struct {
+			Name string `json:"name"`
+		}
+		if err := json.Unmarshal(rawNode, &node); err != nil {
+			resp.Error = function.NewArgumentFuncError(0, "Invalid workflow node: "+err.Error())
+			return
+		}
+
+		if node.Name != name {
+			continue
+		}
+
+		result, err := ConvertJSONToTerraformDynamic(rawNode)
+		if err != nil {
+			resp.Error = function.NewArgumentFuncError(0, "Invalid workflow node: "+err.Error())
+			return
+		}
+
+		resp.Error = function.ConcatFuncErrors(resp.Result.Set(ctx, result))
+		return
+	}
+
+	resp.Error = function.NewArgumentFuncError(1, fmt.Sprintf("No node named %q found in the workflow.", name))
+}
@@ -0,0 +1,54 @@
+// Copyright (c) Arthur Diniz <arthurbdiniz@gmail.com>
+// SPDX-License-Identifier: Apache-2.0
+
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const testWorkflowJSON = `{
+	"name": "My Workflow",
+	"nodes": [
+		{"name": "Start", "type": "n8n-nodes-base.manualTrigger", "parameters": {}},
+		{"name": "HTTP Request", "type": "n8n-nodes-base.httpRequest", "parameters": {"url": "https://example.com"}}
+	]
+}`
+
+func TestWorkflowNodeFunction(t *testing.T) {
+	resp := runWorkflowFunction(t, NewWorkflowNodeFunction(), types.DynamicUnknown(),
+		types.StringValue(testWorkflowJSON), types.StringValue("HTTP Request"))
+	require.Nil(t, resp.Error)
+
+	result, ok := resp.Result.Value().(types.Dynamic)
+	require.True(t, ok)
+
+	node, ok := result.UnderlyingValue().(types.Object)
+	require.True(t, ok)
+	assert.Equal(t, types.StringValue("n8n-nodes-base.httpRequest"), node.Attributes()["type"])
+
+	parameters, ok := node.Attributes()["parameters"].(types.Object)
+	require.True(t, ok)
+	assert.Equal(t, types.StringValue("https://example.com"), parameters.Attributes()["url"])
+}
+
+func TestWorkflowNodeFunction_NotFound(t *testing.T) {
+	resp := runWorkflowFunction(t, NewWorkflowNodeFunction(), types.DynamicUnknown(),
+		types.StringValue(testWorkflowJSON), types.StringValue("Missing"))
+	require.NotNil(t, resp.Error)
+	require.NotNil(t, resp.Error.FunctionArgument)
+	assert.Equal(t, int64(1), *resp.Error.FunctionArgument)
+	assert.Contains(t, resp.Error.Text, `"Missing"`)
+}
+
+func TestWorkflowNodeFunction_InvalidJSON(t *testing.T) {
+	resp := runWorkflowFunction(t, NewWorkflowNodeFunction(), types.DynamicUnknown(),
+		types.StringValue(`[]`), types.StringValue("Start"))
+	require.NotNil(t, resp.Error)
+	require.NotNil(t, resp.Error.FunctionArgument)
+	assert.Equal(t, int64(0), *resp.Error.FunctionArgument)
+}
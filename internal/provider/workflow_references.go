@@ -0,0 +1,143 @@
+// Copyright (c) Arthur Diniz <arthurbdiniz@gmail.com>
+// SPDX-License-Identifier: Apache-2.0
+
+package provider
+
+import (
+	"sort"
+	"strings"
+
+	"github.com/arthurbdiniz/terraform-provider-n8n/internal/pkg/n8n-client-go"
+)
+
+// subWorkflowNodeTypes are the node types that run another workflow, selected
+// by the workflowId parameter.
+var subWorkflowNodeTypes = map[string]bool{
+	"n8n-nodes-base.executeWorkflow":        true,
+	"@n8n/n8n-nodes-langchain.toolWorkflow": true,
+}
+
+// workflowReference is a reference to another workflow by ID, from a node
+// running a sub-workflow or from the error workflow setting.
+type workflowReference struct {
+	// Node is the name of the referencing node, empty for the error workflow setting.
+	Node string
+
+	// ID is the referenced workflow ID.
+	ID string
+
+	// Name is the name of the referenced workflow as cached by the editor,
+	// when known.
+	Name string
+
+	// set replaces the referenced workflow ID.
+	set func(id string)
+}
+
+// workflowReferences returns the references to other workflows made by the
+// nodes and settings of workflow, in node order. Sub-workflows loaded from a
+// URL, a file or a parameter, and IDs set by an expression, are not references.
+func workflowReferences(workflow *n8n.Workflow) []workflowReference {
+	var references []workflowReference
+
+	for i := range workflow.Nodes {
+		node := &workflow.Nodes[i]
+		if !subWorkflowNodeTypes[node.Type] {
+			continue
+		}
+		if source, ok := node.Parameters["source"].(string); ok && source != "database" {
+			continue
+		}
+
+		switch value := node.Parameters["workflowId"].(type) {
+		case string:
+			// Older node versions store the ID as a plain string.
+			if value == "" || isExpression(value) {
+				continue
+			}
+			references = append(references, workflowReference{
+				Node: node.Name,
+				ID:   value,
+				set:  func(id string) { node.Parameters["workflowId"] = id },
+			})
+		case map[string]interface{}:
+			// Newer node versions store a resource locator, caching the
+			// name and editor URL of the selected workflow.
+			id, _ := value["value"].(string)
+			if id == "" || isExpression(id) {
+				continue
+			}
+			name, _ := value["cachedResultName"].(string)
+			references = append(references, workflowReference{
+				Node: node.Name,
+				ID:   id,
+				Name: name,
+				set: func(newID string) {
+					value["value"] = newID
+					if url, ok := value["cachedResultUrl"].(string); ok {
+						value["cachedResultUrl"] = strings.Replace(url, "/workflow/"+id, "/workflow/"+newID, 1)
+					}
+				},
+			})
+		}
+	}
+
+	if workflow.Settings.ErrorWorkflow != "" {
+		references = append(references, workflowReference{
+			ID:  workflow.Settings.ErrorWorkflow,
+			set: func(id string) { workflow.Settings.ErrorWorkflow = id },
+		})
+	}
+
+	return references
+}
+
+// remapWorkflowReferences replaces the IDs of the workflows referenced by
+// workflow with their value in ids, keyed by the original ID, and returns the
+// referenced IDs missing from ids, sorted and without duplicates.
+func remapWorkflowReferences(workflow *n8n.Workflow, ids map[string]string) []string {
+	missing := make(map[string]bool)
+	for _, reference := range workflowReferences(workflow) {
+		if id, ok := ids[reference.ID]; ok {
+			reference.set(id)
+			continue
+		}
+		missing[reference.ID] = true
+	}
+
+	unmapped := make([]string, 0, len(missing))
+	for id := range missing {
+		unmapped = append(unmapped, id)
+	}
+	sort.Strings(unmapped)
+	return unmapped
+}
+
+// remapSubWorkflowsByName replaces the IDs of the workflows run by the nodes
+// of workflow with their value in ids, keyed by the workflow name cached by
+// the editor. It returns the number of references replaced and, in node
+// order, the names of the nodes whose reference has no cached name, such as
+// plain string IDs and locators in ID mode, which are left unchanged.
+func remapSubWorkflowsByName(workflow *n8n.Workflow, ids map[string]string) (int, []string) {
+	remapped := 0
+	var unnamed []string
+	for _, reference := range workflowReferences(workflow) {
+		if reference.Node == "" {
+			continue
+		}
+		if reference.Name == "" {
+			unnamed = append(unnamed, reference.Node)
+			continue
+		}
+		if id, ok := ids[reference.Name]; ok {
+			reference.set(id)
+			remapped++
+		}
+	}
+	return remapped, unnamed
+}
+
+// isExpression reports whether a parameter value is an n8n expression.
+func isExpression(value string) bool {
+	return strings.HasPrefix(value, "=")
+}
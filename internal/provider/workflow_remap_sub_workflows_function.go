@@ -0,0 +1,101 @@
+// Copyright (c) Arthur Diniz <arthurbdiniz@gmail.com>
+// SPDX-License-Identifier: Apache-2.0
+
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"strings"
+
+	"github.com/arthurbdiniz/terraform-provider-n8n/internal/pkg/n8n-client-go"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var _ function.Function = &workflowRemapSubWorkflowsFunction{}
+
+// NewWorkflowRemapSubWorkflowsFunction is a helper function to simplify the provider implementation.
+func NewWorkflowRemapSubWorkflowsFunction() function.Function {
+	return &workflowRemapSubWorkflowsFunction{}
+}
+
+// workflowRemapSubWorkflowsFunction is the function implementation.
+type workflowRemapSubWorkflowsFunction struct{}
+
+// Metadata returns the function name.
+func (f *workflowRemapSubWorkflowsFunction) Metadata(_ context.Context, _ function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "workflow_remap_sub_workflows"
+}
+
+// Definition defines the parameters and return type of the function.
+func (f *workflowRemapSubWorkflowsFunction) Definition(_ context.Context, _ function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary:     "Point the sub-workflow nodes of an n8n workflow JSON document at other workflows.",
+		Description: "Returns the workflow JSON document with the workflow ID of every Execute Workflow and Call n8n Workflow Tool node replaced by the ID mapped to the name of the workflow it runs, so a parent workflow can be deployed together with its sub-workflows to another instance. Nodes are matched by the workflow name the editor stores with the selected workflow, and fail the call when they select their workflow by ID only; nodes selecting their workflow by expression or from another source, and workflows missing from the map, are left unchanged.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:        "json",
+				Description: "The workflow JSON document, for example `file(\"workflow.json\")`.",
+			},
+			function.MapParameter{
+				Name:        "workflow_ids",
+				Description: "The IDs of the workflows to run, by workflow name, for example `{ \"Notify\" = n8n_workflow.notify.id }`.",
+				ElementType: types.StringType,
+			},
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+// Run replaces the sub-workflow IDs in the workflow JSON document.
+func (f *workflowRemapSubWorkflowsFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var input string
+	var ids map[string]string
+
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &input, &ids))
+	if resp.Error != nil {
+		return
+	}
+
+	// Only the nodes are decoded, so the other properties of the document
+	// are returned as they are.
+	var document map[string]json.RawMessage
+	if err := json.Unmarshal([]byte(input), &document); err != nil {
+		resp.Error = function.NewArgumentFuncError(0, "Invalid workflow JSON: "+err.Error())
+		return
+	}
+
+	var workflow n8n.Workflow
+	if nodes, ok := document["nodes"]; ok {
+		if err := json.Unmarshal(nodes, &workflow.Nodes); err != nil {
+			resp.Error = function.NewArgumentFuncError(0, "Invalid workflow nodes: "+err.Error())
+			return
+		}
+	}
+
+	remapped, unnamed := remapSubWorkflowsByName(&workflow, ids)
+	if len(unnamed) > 0 {
+		resp.Error = function.NewArgumentFuncError(0, "These nodes select their sub-workflow by ID only, so it cannot be matched by name; "+
+			"select it from the list in the n8n editor or remap the ID yourself: "+strings.Join(unnamed, ", "))
+		return
+	}
+
+	if remapped > 0 {
+		nodes, err := json.Marshal(workflow.Nodes)
+		if err != nil {
+			resp.Error = function.NewFuncError("Unable to encode workflow nodes: " + err.Error())
+			return
+		}
+		document["nodes"] = nodes
+	}
+
+	output, err := MarshalCanonicalJSON(document)
+	if err != nil {
+		resp.Error = function.NewFuncError("Unable to encode workflow: " + err.Error())
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Result.Set(ctx, output))
+}
@@ -0,0 +1,88 @@
+// Copyright (c) Arthur Diniz <arthurbdiniz@gmail.com>
+// SPDX-License-Identifier: Apache-2.0
+
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const testParentWorkflowJSON = `{
+	"name": "Orders",
+	"nodes": [
+		{"name": "Notify", "type": "n8n-nodes-base.executeWorkflow", "typeVersion": 1.2, "position": [0, 0], "webhookId": "abc",
+		 "parameters": {"workflowId": {"__rl": true, "mode": "list", "value": "old-notify", "cachedResultName": "Notify", "cachedResultUrl": "/workflow/old-notify"}}},
+		{"name": "Archive", "type": "n8n-nodes-base.executeWorkflow", "typeVersion": 1.2, "position": [200, 0],
+		 "parameters": {"workflowId": {"__rl": true, "mode": "list", "value": "old-archive", "cachedResultName": "Archive"}}}
+	],
+	"connections": {},
+	"settings": {"errorWorkflow": "old-notify"},
+	"meta": {"instanceId": "abc"}
+}`
+
+func TestWorkflowRemapSubWorkflowsFunction(t *testing.T) {
+	ids := types.MapValueMust(types.StringType, map[string]attr.Value{
+		"Notify": types.StringValue("new-notify"),
+		"Unused": types.StringValue("new-unused"),
+	})
+
+	resp := runWorkflowFunction(t, NewWorkflowRemapSubWorkflowsFunction(), types.StringUnknown(),
+		types.StringValue(testParentWorkflowJSON), ids)
+	require.Nil(t, resp.Error)
+
+	result, ok := resp.Result.Value().(types.String)
+	require.True(t, ok)
+
+	workflow, err := ParseWorkflowJSON(result.ValueString())
+	require.NoError(t, err)
+
+	assert.Equal(t, map[string]interface{}{
+		"__rl":             true,
+		"mode":             "list",
+		"value":            "new-notify",
+		"cachedResultName": "Notify",
+		"cachedResultUrl":  "/workflow/new-notify",
+	}, workflow.Nodes[0].Parameters["workflowId"])
+	assert.Equal(t, "old-archive", workflow.Nodes[1].Parameters["workflowId"].(map[string]interface{})["value"], "workflows missing from the map are left unchanged")
+	assert.Equal(t, "old-notify", workflow.Settings.ErrorWorkflow, "only nodes are remapped")
+	assert.Contains(t, result.ValueString(), `"webhookId":"abc"`)
+	assert.Contains(t, result.ValueString(), `"meta":{"instanceId":"abc"}`)
+}
+
+func TestWorkflowRemapSubWorkflowsFunction_InvalidJSON(t *testing.T) {
+	resp := runWorkflowFunction(t, NewWorkflowRemapSubWorkflowsFunction(), types.StringUnknown(),
+		types.StringValue(`{"nodes": {}}`), types.MapValueMust(types.StringType, map[string]attr.Value{}))
+	require.NotNil(t, resp.Error)
+	require.NotNil(t, resp.Error.FunctionArgument)
+	assert.Equal(t, int64(0), *resp.Error.FunctionArgument)
+}
+
+func TestWorkflowRemapSubWorkflowsFunction_UnnamedReference(t *testing.T) {
+	// Plain string IDs and locators in ID mode have no cached workflow name
+	input := `{
+		"nodes": [
+			{"name": "Notify", "type": "n8n-nodes-base.executeWorkflow", "typeVersion": 1.2, "position": [0, 0],
+			 "parameters": {"workflowId": {"__rl": true, "mode": "list", "value": "old-notify", "cachedResultName": "Notify"}}},
+			{"name": "Legacy", "type": "n8n-nodes-base.executeWorkflow", "typeVersion": 1, "position": [200, 0],
+			 "parameters": {"workflowId": "old-notify"}},
+			{"name": "By ID", "type": "@n8n/n8n-nodes-langchain.toolWorkflow", "typeVersion": 2, "position": [400, 0],
+			 "parameters": {"workflowId": {"__rl": true, "mode": "id", "value": "old-notify"}}}
+		],
+		"connections": {}
+	}`
+	ids := types.MapValueMust(types.StringType, map[string]attr.Value{
+		"Notify": types.StringValue("new-notify"),
+	})
+
+	resp := runWorkflowFunction(t, NewWorkflowRemapSubWorkflowsFunction(), types.StringUnknown(),
+		types.StringValue(input), ids)
+	require.NotNil(t, resp.Error)
+	require.NotNil(t, resp.Error.FunctionArgument)
+	assert.Equal(t, int64(0), *resp.Error.FunctionArgument)
+	assert.Contains(t, resp.Error.Text, "Legacy, By ID")
+}
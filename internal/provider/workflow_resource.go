@@ -0,0 +1,504 @@
+// Copyright (c) Arthur Diniz <arthurbdiniz@gmail.com>
+// SPDX-License-Identifier: Apache-2.0
+
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/arthurbdiniz/terraform-provider-n8n/internal/pkg/n8n-client-go"
+	"github.com/arthurbdiniz/terraform-provider-n8n/internal/validation"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                   = &workflowResource{}
+	_ resource.ResourceWithConfigure      = &workflowResource{}
+	_ resource.ResourceWithImportState    = &workflowResource{}
+	_ resource.ResourceWithModifyPlan     = &workflowResource{}
+	_ resource.ResourceWithValidateConfig = &workflowResource{}
+)
+
+// NewWorkflowResource is a helper function to simplify the provider implementation.
+func NewWorkflowResource() resource.Resource {
+	return &workflowResource{}
+}
+
+// workflowResource is the resource implementation.
+type workflowResource struct {
+	client *n8n.Client
+}
+
+// workflowResourceModel maps the resource schema data.
+type workflowResourceModel struct {
+	ID                  types.String      `tfsdk:"id"`
+	WorkflowJSON        WorkflowJSONValue `tfsdk:"workflow_json"`
+	IgnoreNodePositions types.Bool        `tfsdk:"ignore_node_positions"`
+	Active              types.Bool        `tfsdk:"active"`
+	CredentialIDs       types.Map         `tfsdk:"credential_ids"`
+	Name                types.String      `tfsdk:"name"`
+	VersionId           types.String      `tfsdk:"version_id"`
+	CreatedAt           types.String      `tfsdk:"created_at"`
+	UpdatedAt           types.String      `tfsdk:"updated_at"`
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *workflowResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Add a nil check when handling ProviderData because Terraform
+	// sets that data after it calls the ConfigureProvider RPC.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*n8n.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *n8n.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+// Metadata returns the resource type name.
+func (r *workflowResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_workflow"
+}
+
+// Schema defines the schema for the resource.
+func (r *workflowResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manages a workflow from a JSON document exported from the n8n editor.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:    true,
+				Description: "Unique identifier of the workflow.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"workflow_json": schema.StringAttribute{
+				CustomType: WorkflowJSONType{},
+				Required:   true,
+				Description: "The workflow JSON document as exported from the n8n editor, for example `file(\"workflow.json\")`. " +
+					"Instance-specific fields such as `id`, `versionId`, `active`, `tags`, timestamps and node IDs are ignored, and " +
+					"differences in key order, formatting or empty default values do not cause changes. " +
+					"During plan, duplicate node names or IDs, connections to unknown nodes and unconnected nodes are reported, " +
+					"and when `active` is `true`, a workflow without a trigger node is reported as a warning.",
+				PlanModifiers: []planmodifier.String{
+					workflowJSONSemanticEquality(),
+				},
+			},
+			"ignore_node_positions": schema.BoolAttribute{
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(false),
+				Description: "Ignore the position of nodes on the canvas when detecting changes. Defaults to `false`.",
+			},
+			"active": schema.BoolAttribute{
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(false),
+				Description: "Whether the workflow is active. Defaults to `false`.",
+			},
+			"credential_ids": schema.MapAttribute{
+				ElementType: types.StringType,
+				Optional:    true,
+				Description: "IDs of credentials on the n8n instance, keyed by credential name. When the workflow is created or updated, " +
+					"the credentials referenced by its nodes are resolved by type and name to the credentials of the instance, so a " +
+					"workflow exported from another instance uses the local credentials. Set this for credentials that cannot be " +
+					"resolved, such as on n8n versions whose public API cannot list credentials or when several credentials share a name.",
+			},
+			"name": schema.StringAttribute{
+				Computed:    true,
+				Description: "Name of the workflow, taken from the workflow JSON document.",
+			},
+			"version_id": schema.StringAttribute{
+				Computed:    true,
+				Description: "Identifier of the current version of the workflow.",
+			},
+			"created_at": schema.StringAttribute{
+				Computed:    true,
+				Description: "Timestamp when the workflow was created.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"updated_at": schema.StringAttribute{
+				Computed:    true,
+				Description: "Timestamp when the workflow was last updated.",
+			},
+		},
+	}
+}
+
+// ValidateConfig checks the settings in the workflow JSON document with the
+// same rules as the n8n_workflow_settings resource, and the nodes and
+// connections of the workflow, before any API call.
+func (r *workflowResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var workflowJSON WorkflowJSONValue
+	var active types.Bool
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("workflow_json"), &workflowJSON)...)
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("active"), &active)...)
+	if resp.Diagnostics.HasError() || workflowJSON.IsNull() || workflowJSON.IsUnknown() {
+		return
+	}
+
+	// Documents that cannot be parsed are reported by the plan modifier.
+	workflow, err := ParseWorkflowJSON(workflowJSON.ValueString())
+	if err != nil {
+		return
+	}
+
+	for _, problem := range validateWorkflowSettings(workflow.Settings) {
+		resp.Diagnostics.AddAttributeError(path.Root("workflow_json"), "Invalid Workflow Settings", problem)
+	}
+
+	issues := validation.ValidateWorkflow(*workflow, validation.Options{RequireTrigger: active.ValueBool()})
+	for _, issue := range issues {
+		if issue.Severity == validation.SeverityWarning {
+			resp.Diagnostics.AddAttributeWarning(path.Root("workflow_json"), "Workflow Graph Warning", issue.String())
+			continue
+		}
+		resp.Diagnostics.AddAttributeError(path.Root("workflow_json"), "Invalid Workflow Graph", issue.String())
+	}
+}
+
+// ModifyPlan keeps the computed attributes from the prior state when the
+// workflow is semantically unchanged, and derives the name from the document.
+func (r *workflowResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var plan workflowResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !req.State.Raw.IsNull() {
+		var state workflowResourceModel
+		resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		if plan.WorkflowJSON.Equal(state.WorkflowJSON) &&
+			plan.Active.Equal(state.Active) &&
+			plan.CredentialIDs.Equal(state.CredentialIDs) &&
+			plan.IgnoreNodePositions.Equal(state.IgnoreNodePositions) {
+			resp.Diagnostics.Append(resp.Plan.Set(ctx, state)...)
+			return
+		}
+	}
+
+	if plan.WorkflowJSON.IsUnknown() {
+		return
+	}
+
+	if workflow, err := ParseWorkflowJSON(plan.WorkflowJSON.ValueString()); err == nil {
+		plan.Name = types.StringValue(workflow.Name)
+		resp.Diagnostics.Append(resp.Plan.Set(ctx, plan)...)
+	}
+}
+
+// Create creates the resource and sets the initial Terraform state.
+func (r *workflowResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	if !checkClient(r.client, &resp.Diagnostics) {
+		return
+	}
+
+	var plan workflowResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	workflow, err := ParseWorkflowJSON(plan.WorkflowJSON.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("workflow_json"), "Invalid Workflow JSON", err.Error())
+		return
+	}
+
+	if !r.resolveCredentials(ctx, workflow, plan.CredentialIDs, &resp.Diagnostics) {
+		return
+	}
+
+	created, err := r.client.WithContext(ctx).CreateWorkflow(&n8n.CreateWorkflowRequest{
+		Name:        workflow.Name,
+		Nodes:       workflow.Nodes,
+		Connections: workflow.Connections,
+		Settings:    workflow.Settings,
+		PinData:     workflow.PinData,
+		StaticData:  workflow.StaticData,
+	})
+	if err != nil {
+		resp.Diagnostics.AddError("Error creating workflow", err.Error())
+		return
+	}
+
+	// The workflow exists from here on, so it is saved to the state even
+	// when activation fails, with its actual activation state.
+	created = applyWorkflowActive(r.client.WithContext(ctx), created, plan.Active.ValueBool(), &resp.Diagnostics)
+	plan.Active = types.BoolValue(created.Active)
+	plan.setComputed(created)
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (r *workflowResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	if !checkClient(r.client, &resp.Diagnostics) {
+		return
+	}
+
+	var state workflowResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	workflow, err := r.client.WithContext(ctx).GetWorkflow(state.ID.ValueString())
+	if n8n.IsNotFound(err) {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError("Error retrieving workflow", err.Error())
+		return
+	}
+
+	if state.IgnoreNodePositions.IsNull() {
+		state.IgnoreNodePositions = types.BoolValue(false)
+	}
+
+	remote, err := json.Marshal(workflow)
+	if err != nil {
+		resp.Diagnostics.AddError("Error encoding workflow", err.Error())
+		return
+	}
+
+	actual, err := NormalizeWorkflowJSON(string(remote), state.IgnoreNodePositions.ValueBool())
+	if err != nil {
+		resp.Diagnostics.AddError("Error normalizing workflow", err.Error())
+		return
+	}
+
+	// Keep the configured document as long as it still describes the
+	// workflow in n8n, otherwise record the remote version to surface drift.
+	if state.WorkflowJSON.IsNull() {
+		state.WorkflowJSON = NewWorkflowJSONValue(actual)
+	} else {
+		expected, err := NormalizeWorkflowJSON(state.WorkflowJSON.ValueString(), state.IgnoreNodePositions.ValueBool())
+		if err != nil || expected != actual {
+			if err == nil {
+				drift, driftErr := WorkflowNodeDrift(expected, actual)
+				if driftErr == nil && len(drift) > 0 {
+					resp.Diagnostics.AddWarning(
+						"Workflow changed outside of Terraform",
+						fmt.Sprintf("The workflow %q was modified in n8n:\n\n- %s", workflow.ID, strings.Join(drift, "\n- ")),
+					)
+				}
+			}
+			state.WorkflowJSON = NewWorkflowJSONValue(actual)
+		}
+	}
+
+	state.Active = types.BoolValue(workflow.Active)
+	state.setComputed(workflow)
+
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Update updates the resource and sets the updated Terraform state on success.
+func (r *workflowResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	if !checkClient(r.client, &resp.Diagnostics) {
+		return
+	}
+
+	var plan, state workflowResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	workflow, err := ParseWorkflowJSON(plan.WorkflowJSON.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("workflow_json"), "Invalid Workflow JSON", err.Error())
+		return
+	}
+
+	if !r.resolveCredentials(ctx, workflow, plan.CredentialIDs, &resp.Diagnostics) {
+		return
+	}
+
+	updated, err := r.client.WithContext(ctx).UpdateWorkflow(state.ID.ValueString(), &n8n.UpdateWorkflowRequest{
+		Name:        workflow.Name,
+		Nodes:       workflow.Nodes,
+		Connections: workflow.Connections,
+		Settings:    workflow.Settings,
+		PinData:     workflow.PinData,
+		StaticData:  workflow.StaticData,
+	})
+	if err != nil {
+		resp.Diagnostics.AddError("Error updating workflow", err.Error())
+		return
+	}
+
+	// The workflow was updated, so the new document is saved to the state
+	// even when activation fails, with its actual activation state.
+	updated = applyWorkflowActive(r.client.WithContext(ctx), updated, plan.Active.ValueBool(), &resp.Diagnostics)
+	plan.Active = types.BoolValue(updated.Active)
+	plan.setComputed(updated)
+
+	diags := resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Delete deletes the resource and removes the Terraform state on success.
+func (r *workflowResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	if !checkClient(r.client, &resp.Diagnostics) {
+		return
+	}
+
+	var state workflowResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	_, err := r.client.WithContext(ctx).DeleteWorkflow(state.ID.ValueString())
+	if err != nil && !n8n.IsNotFound(err) {
+		resp.Diagnostics.AddError("Error deleting workflow", err.Error())
+		return
+	}
+}
+
+// ImportState imports an existing workflow by its ID.
+func (r *workflowResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+// resolveCredentials points the credential references of the workflow nodes
+// at the credentials of the instance, reporting failures as diagnostics.
+func (r *workflowResource) resolveCredentials(ctx context.Context, workflow *n8n.Workflow, credentialIDs types.Map, diags *diag.Diagnostics) bool {
+	ids := make(map[string]string)
+	if !credentialIDs.IsNull() && !credentialIDs.IsUnknown() {
+		diags.Append(credentialIDs.ElementsAs(ctx, &ids, false)...)
+		if diags.HasError() {
+			return false
+		}
+	}
+
+	if err := resolveCredentials(r.client.WithContext(ctx), workflow, credentialIDsByName(ids)); err != nil {
+		diags.AddAttributeError(path.Root("workflow_json"), "Unable to Resolve Workflow Credentials", err.Error())
+		return false
+	}
+	return true
+}
+
+// applyWorkflowActive activates or deactivates a workflow that was just
+// created or updated. On failure, such as n8n refusing to activate a
+// workflow without a trigger, the error is added to diags and the workflow
+// is returned unchanged, so callers still save it to the Terraform state
+// rather than leaving it untracked in n8n.
+func applyWorkflowActive(client *n8n.Client, workflow *n8n.Workflow, active bool, diags *diag.Diagnostics) *n8n.Workflow {
+	updated, err := setWorkflowActive(client, workflow, active)
+	if err != nil {
+		diags.AddError("Error changing workflow activation", err.Error())
+		return workflow
+	}
+	return updated
+}
+
+// setWorkflowActive activates or deactivates the workflow when its current
+// state differs from the desired one.
+func setWorkflowActive(client *n8n.Client, workflow *n8n.Workflow, active bool) (*n8n.Workflow, error) {
+	if workflow.Active == active {
+		return workflow, nil
+	}
+
+	if active {
+		return client.ActivateWorkflow(workflow.ID)
+	}
+	return client.DeactivateWorkflow(workflow.ID)
+}
+
+// setComputed copies the attributes assigned by n8n into the model.
+func (m *workflowResourceModel) setComputed(workflow *n8n.Workflow) {
+	m.ID = types.StringValue(workflow.ID)
+	m.Name = types.StringValue(workflow.Name)
+	m.VersionId = types.StringValue(workflow.VersionId)
+	m.CreatedAt = types.StringValue(workflow.CreatedAt)
+	m.UpdatedAt = types.StringValue(workflow.UpdatedAt)
+}
+
+// workflowJSONSemanticEqualityModifier keeps the prior workflow_json value when
+// the planned document only differs in ways ignored by the resource, including
+// node positions when ignore_node_positions is set, which WorkflowJSONType
+// cannot account for on its own.
+type workflowJSONSemanticEqualityModifier struct{}
+
+func workflowJSONSemanticEquality() planmodifier.String {
+	return workflowJSONSemanticEqualityModifier{}
+}
+
+func (m workflowJSONSemanticEqualityModifier) Description(_ context.Context) string {
+	return "Suppresses differences in the workflow JSON document that are ignored by the resource, such as node positions when ignore_node_positions is set."
+}
+
+func (m workflowJSONSemanticEqualityModifier) MarkdownDescription(ctx context.Context) string {
+	return m.Description(ctx)
+}
+
+func (m workflowJSONSemanticEqualityModifier) PlanModifyString(ctx context.Context, req planmodifier.StringRequest, resp *planmodifier.StringResponse) {
+	if req.PlanValue.IsNull() || req.PlanValue.IsUnknown() {
+		return
+	}
+
+	var ignorePositions types.Bool
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("ignore_node_positions"), &ignorePositions)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	planned, err := NormalizeWorkflowJSON(req.PlanValue.ValueString(), ignorePositions.ValueBool())
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(req.Path, "Invalid Workflow JSON", err.Error())
+		return
+	}
+
+	if req.StateValue.IsNull() {
+		return
+	}
+
+	prior, err := NormalizeWorkflowJSON(req.StateValue.ValueString(), ignorePositions.ValueBool())
+	if err != nil {
+		return
+	}
+
+	if planned == prior {
+		resp.PlanValue = req.StateValue
+	}
+}
@@ -0,0 +1,362 @@
+// Copyright (c) Arthur Diniz <arthurbdiniz@gmail.com>
+// SPDX-License-Identifier: Apache-2.0
+
+package provider
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/arthurbdiniz/terraform-provider-n8n/internal/helpers"
+	"github.com/arthurbdiniz/terraform-provider-n8n/internal/pkg/n8n-client-go/n8ntest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/stretchr/testify/require"
+)
+
+func TestWorkflowResource(t *testing.T) {
+	// Start the n8n container for testing
+	container, url, err := helpers.CreateTestContainer()
+	require.NoError(t, err)
+
+	defer helpers.DeferTerminate(container)()
+
+	t.Logf("n8n test container running at %s", url)
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: GetProviderConfig(url) + `
+					resource "n8n_workflow" "test" {
+						workflow_json = jsonencode({
+							id   = "ignored"
+							name = "Exported Workflow"
+							nodes = [{
+								id          = "1"
+								name        = "Start"
+								type        = "n8n-nodes-base.manualTrigger"
+								typeVersion = 1
+								position    = [0, 0]
+								parameters  = {}
+							}]
+							connections = {}
+							settings    = { executionOrder = "v1" }
+						})
+					}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("n8n_workflow.test", "id"),
+					resource.TestCheckResourceAttr("n8n_workflow.test", "name", "Exported Workflow"),
+					resource.TestCheckResourceAttr("n8n_workflow.test", "active", "false"),
+					resource.TestCheckResourceAttrSet("n8n_workflow.test", "version_id"),
+				),
+			},
+			// Reformatted document does not cause changes
+			{
+				Config: GetProviderConfig(url) + `
+					resource "n8n_workflow" "test" {
+						workflow_json = <<-EOT
+						{
+							"settings": {"executionOrder": "v1"},
+							"connections": {},
+							"nodes": [
+								{"parameters": {}, "position": [0, 0], "typeVersion": 1, "type": "n8n-nodes-base.manualTrigger", "name": "Start", "id": "1"}
+							],
+							"name": "Exported Workflow",
+							"versionId": "ignored"
+						}
+						EOT
+					}
+				`,
+				PlanOnly: true,
+			},
+			// ImportState testing
+			{
+				ResourceName:            "n8n_workflow.test",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"workflow_json"},
+			},
+		},
+	})
+}
+
+func TestWorkflowResource_FakeServer(t *testing.T) {
+	server := newTestServer(t)
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: GetProviderConfig(server.URL) + `
+					resource "n8n_workflow" "test" {
+						workflow_json = jsonencode({
+							name = "Exported Workflow"
+							nodes = [{
+								id          = "1"
+								name        = "Start"
+								type        = "n8n-nodes-base.manualTrigger"
+								typeVersion = 1
+								position    = [0, 0]
+								parameters  = {}
+							}]
+							connections = {}
+							settings    = { executionOrder = "v1" }
+						})
+					}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("n8n_workflow.test", "id"),
+					resource.TestCheckResourceAttr("n8n_workflow.test", "name", "Exported Workflow"),
+					resource.TestCheckResourceAttr("n8n_workflow.test", "active", "false"),
+					resource.TestCheckResourceAttrSet("n8n_workflow.test", "version_id"),
+				),
+			},
+			// Update and activate testing
+			{
+				Config: GetProviderConfig(server.URL) + `
+					resource "n8n_workflow" "test" {
+						active        = true
+						workflow_json = jsonencode({
+							name = "Scheduled Workflow"
+							nodes = [{
+								id          = "1"
+								name        = "Every Hour"
+								type        = "n8n-nodes-base.scheduleTrigger"
+								typeVersion = 1.2
+								position    = [0, 0]
+								parameters  = {}
+							}]
+							connections = {}
+							settings    = { executionOrder = "v1" }
+						})
+					}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("n8n_workflow.test", "name", "Scheduled Workflow"),
+					resource.TestCheckResourceAttr("n8n_workflow.test", "active", "true"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:            "n8n_workflow.test",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"workflow_json"},
+			},
+		},
+	})
+
+	require.Empty(t, server.Workflows(), "workflow should be deleted on destroy")
+}
+
+func TestWorkflowResource_GraphValidation(t *testing.T) {
+	server := newUnreachableAPI(t)
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testProtoV6ProviderFactoriesForURL(t, server.URL),
+		Steps: []resource.TestStep{
+			{
+				Config: `
+					resource "n8n_workflow" "test" {
+						workflow_json = jsonencode({
+							name = "Broken Graph"
+							nodes = [
+								{ id = "1", name = "Webhook", type = "n8n-nodes-base.webhook", typeVersion = 2, position = [0, 0], parameters = {} },
+								{ id = "2", name = "Webhook", type = "n8n-nodes-base.set", typeVersion = 3, position = [200, 0], parameters = {} },
+							]
+							connections = {
+								Webhook = { main = [[{ node = "Respond", type = "main", index = 0 }]] }
+							}
+						})
+					}
+				`,
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile(`(?s)nodes\[1\]\.name: node name "Webhook" is already used by nodes\[0\].*connections\["Webhook"\]\.main\[0\]\[0\]\.node: connection target\s+"Respond"`),
+			},
+			{
+				Config: `
+					resource "n8n_workflow" "test" {
+						active        = true
+						workflow_json = jsonencode({
+							name        = "Manual Only"
+							nodes       = [{ id = "1", name = "Start", type = "n8n-nodes-base.manualTrigger", typeVersion = 1, position = [0, 0], parameters = {} }]
+							connections = {}
+						})
+					}
+				`,
+				// A missing trigger is only a warning: n8n decides on activation
+				PlanOnly:           true,
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestWorkflowResource_RefreshIsStable(t *testing.T) {
+	server := newTestServer(t)
+
+	// A workflow with fractional node positions and parameters of every
+	// kind, read through both the resource and the data source.
+	configuration := GetProviderConfig(server.URL) + `
+		resource "n8n_workflow" "test" {
+			workflow_json = jsonencode({
+				name = "Stable Workflow"
+				nodes = [{
+					id          = "1"
+					name        = "Webhook"
+					type        = "n8n-nodes-base.webhook"
+					typeVersion = 2
+					position    = [240.5, -80.25]
+					webhookId   = "0c6d8b64"
+					parameters  = {
+						path       = "orders"
+						httpMethod = "POST"
+						options    = { rawBody = true, responseHeaders = { entries = [{ name = "X-Id", value = "1" }] } }
+					}
+				}, {
+					id          = "2"
+					name        = "Set"
+					type        = "n8n-nodes-base.set"
+					typeVersion = 3.4
+					position    = [460, 0]
+					parameters  = { zeta = 1.5, alpha = "a", mode = "manual", keepOnlySet = false, fields = ["b", "a"] }
+				}]
+				connections = { Webhook = { main = [[{ node = "Set", type = "main", index = 0 }]] } }
+				settings    = { executionOrder = "v1" }
+			})
+		}
+
+		data "n8n_workflow" "test" {
+			id = n8n_workflow.test.id
+		}
+	`
+
+	var snapshot map[string]map[string]string
+	takeSnapshot := func(s *terraform.State) error {
+		snapshot = make(map[string]map[string]string)
+		for name, rs := range s.RootModule().Resources {
+			snapshot[name] = rs.Primary.Attributes
+		}
+		return nil
+	}
+	compareSnapshot := func(s *terraform.State) error {
+		for name, rs := range s.RootModule().Resources {
+			before, after := snapshot[name], rs.Primary.Attributes
+			for key, value := range after {
+				if previous, ok := before[key]; !ok || previous != value {
+					return fmt.Errorf("refresh changed the state of %s: %s is %q, was %q", name, key, value, previous)
+				}
+			}
+			for key, previous := range before {
+				if _, ok := after[key]; !ok {
+					return fmt.Errorf("refresh changed the state of %s: %s is no longer set, was %q", name, key, previous)
+				}
+			}
+		}
+		return nil
+	}
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: configuration,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.n8n_workflow.test", "nodes.0.position.0", "240.5"),
+					resource.TestCheckResourceAttr("data.n8n_workflow.test", "nodes.0.position.1", "-80.25"),
+					resource.TestCheckResourceAttr("data.n8n_workflow.test", "nodes.1.parameters.0.key", "alpha"),
+					resource.TestCheckResourceAttr("data.n8n_workflow.test", "nodes.1.parameters.4.key", "zeta"),
+					takeSnapshot,
+				),
+			},
+			{
+				RefreshState: true,
+				Check:        compareSnapshot,
+			},
+			{
+				RefreshState: true,
+				Check:        compareSnapshot,
+			},
+			{
+				Config:   configuration,
+				PlanOnly: true,
+			},
+		},
+	})
+}
+
+func TestWorkflowResource_ActivationFailure(t *testing.T) {
+	server := newTestServer(t)
+	server.CanActivate = func(n8ntest.Workflow) error {
+		return errors.New("activation refused")
+	}
+
+	configuration := func(name string, active bool) string {
+		return GetProviderConfig(server.URL) + fmt.Sprintf(`
+			resource "n8n_workflow" "test" {
+				active        = %t
+				workflow_json = jsonencode({
+					name = %q
+					nodes = [{
+						id          = "1"
+						name        = "Every Hour"
+						type        = "n8n-nodes-base.scheduleTrigger"
+						typeVersion = 1.2
+						position    = [0, 0]
+						parameters  = {}
+					}]
+					connections = {}
+					settings    = { executionOrder = "v1" }
+				})
+			}
+		`, active, name)
+	}
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// A workflow failing to activate on create is still tracked
+			{
+				Config:      configuration("Created", true),
+				ExpectError: regexp.MustCompile(`activation refused`),
+			},
+			{
+				PreConfig: func() {
+					require.Len(t, server.Workflows(), 1)
+					server.CanActivate = nil
+				},
+				Config: configuration("Created", true),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("n8n_workflow.test", "active", "true"),
+					func(*terraform.State) error {
+						require.Len(t, server.Workflows(), 1, "the workflow must not be created twice")
+						return nil
+					},
+				),
+			},
+			{
+				Config: configuration("Created", false),
+			},
+			// The updated document is saved when activation fails on update
+			{
+				PreConfig: func() {
+					server.CanActivate = func(n8ntest.Workflow) error {
+						return errors.New("activation refused")
+					}
+				},
+				Config:      configuration("Updated", true),
+				ExpectError: regexp.MustCompile(`activation refused`),
+			},
+			{
+				Config:   configuration("Updated", false),
+				PlanOnly: true,
+			},
+		},
+	})
+}
@@ -0,0 +1,311 @@
+// Copyright (c) Arthur Diniz <arthurbdiniz@gmail.com>
+// SPDX-License-Identifier: Apache-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/arthurbdiniz/terraform-provider-n8n/internal/pkg/n8n-client-go"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                = &workflowSettingsResource{}
+	_ resource.ResourceWithConfigure   = &workflowSettingsResource{}
+	_ resource.ResourceWithImportState = &workflowSettingsResource{}
+)
+
+// NewWorkflowSettingsResource is a helper function to simplify the provider implementation.
+func NewWorkflowSettingsResource() resource.Resource {
+	return &workflowSettingsResource{}
+}
+
+// workflowSettingsResource is the resource implementation.
+type workflowSettingsResource struct {
+	client *n8n.Client
+}
+
+// workflowSettingsResourceModel maps the resource schema data.
+type workflowSettingsResourceModel struct {
+	ID                       types.String `tfsdk:"id"`
+	WorkflowID               types.String `tfsdk:"workflow_id"`
+	SaveExecutionProgress    types.Bool   `tfsdk:"save_execution_progress"`
+	SaveManualExecutions     types.Bool   `tfsdk:"save_manual_executions"`
+	SaveDataErrorExecution   types.String `tfsdk:"save_data_error_execution"`
+	SaveDataSuccessExecution types.String `tfsdk:"save_data_success_execution"`
+	ExecutionTimeout         types.Int64  `tfsdk:"execution_timeout"`
+	ErrorWorkflow            types.String `tfsdk:"error_workflow"`
+	Timezone                 types.String `tfsdk:"timezone"`
+	ExecutionOrder           types.String `tfsdk:"execution_order"`
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *workflowSettingsResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Add a nil check when handling ProviderData because Terraform
+	// sets that data after it calls the ConfigureProvider RPC.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*n8n.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *n8n.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+// Metadata returns the resource type name.
+func (r *workflowSettingsResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_workflow_settings"
+}
+
+// Schema defines the schema for the resource.
+func (r *workflowSettingsResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	// Settings left out of the configuration follow the value in n8n, so only
+	// the configured ones are enforced and reported as drift.
+	optionalString := func(description string, validators []validator.String) schema.StringAttribute {
+		return schema.StringAttribute{
+			Optional:      true,
+			Computed:      true,
+			Description:   description,
+			Validators:    validators,
+			PlanModifiers: []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+		}
+	}
+	optionalBool := func(description string) schema.BoolAttribute {
+		return schema.BoolAttribute{
+			Optional:      true,
+			Computed:      true,
+			Description:   description,
+			PlanModifiers: []planmodifier.Bool{boolplanmodifier.UseStateForUnknown()},
+		}
+	}
+
+	resp.Schema = schema.Schema{
+		Description: "Enforces the execution settings of an existing workflow without managing its nodes and connections, " +
+			"so the workflow content can be edited in the n8n editor. Only the settings set in the configuration are managed; " +
+			"the others keep their value in n8n. Destroying the resource only removes it from the Terraform state: " +
+			"the workflow keeps the settings last applied, and their previous values are not restored.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:    true,
+				Description: "Identifier of the resource, equal to `workflow_id`.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"workflow_id": schema.StringAttribute{
+				Required:    true,
+				Description: "ID of the workflow whose settings are managed.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"save_execution_progress":     optionalBool("Whether to save execution progress after each node."),
+			"save_manual_executions":      optionalBool("Whether to save executions started manually from the editor."),
+			"save_data_error_execution":   optionalString("Whether to save data of failed executions, `all` or `none`.", saveDataValidators()),
+			"save_data_success_execution": optionalString("Whether to save data of successful executions, `all` or `none`.", saveDataValidators()),
+			"execution_timeout": schema.Int64Attribute{
+				Optional:      true,
+				Computed:      true,
+				Description:   "Maximum execution time of the workflow, in seconds, at most `3600`. `-1` disables the timeout.",
+				Validators:    executionTimeoutValidators(),
+				PlanModifiers: []planmodifier.Int64{int64planmodifier.UseStateForUnknown()},
+			},
+			"error_workflow":  optionalString("ID of the workflow to run when an execution of this workflow fails.", workflowIDValidators()),
+			"timezone":        optionalString("IANA name of the timezone used by the workflow, for example by schedule triggers, such as `Europe/Berlin`.", timezoneValidators()),
+			"execution_order": optionalString("Order in which the nodes are executed, `v0` or `v1`.", executionOrderValidators()),
+		},
+	}
+}
+
+// Create applies the configured settings and sets the initial Terraform state.
+func (r *workflowSettingsResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	if !checkClient(r.client, &resp.Diagnostics) {
+		return
+	}
+
+	var plan workflowSettingsResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	workflow, err := r.client.WithContext(ctx).UpdateWorkflowSettings(plan.WorkflowID.ValueString(), plan.apply)
+	if n8n.IsNotFound(err) {
+		addWorkflowNotFoundError(plan.WorkflowID.ValueString(), &resp.Diagnostics)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError("Error updating workflow settings", err.Error())
+		return
+	}
+
+	plan.ID = types.StringValue(workflow.ID)
+	plan.setSettings(workflow.Settings)
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (r *workflowSettingsResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	if !checkClient(r.client, &resp.Diagnostics) {
+		return
+	}
+
+	var state workflowSettingsResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	workflow, err := r.client.WithContext(ctx).GetWorkflow(state.WorkflowID.ValueString())
+	if n8n.IsNotFound(err) {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError("Error retrieving workflow", err.Error())
+		return
+	}
+
+	state.ID = types.StringValue(workflow.ID)
+	state.setSettings(workflow.Settings)
+
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Update applies the configured settings and sets the updated Terraform state on success.
+func (r *workflowSettingsResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	if !checkClient(r.client, &resp.Diagnostics) {
+		return
+	}
+
+	var plan workflowSettingsResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	workflow, err := r.client.WithContext(ctx).UpdateWorkflowSettings(plan.WorkflowID.ValueString(), plan.apply)
+	if n8n.IsNotFound(err) {
+		// The workflow was deleted since the last refresh, so the resource is
+		// removed from the state and recreated by the next plan.
+		addWorkflowNotFoundError(plan.WorkflowID.ValueString(), &resp.Diagnostics)
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError("Error updating workflow settings", err.Error())
+		return
+	}
+
+	plan.setSettings(workflow.Settings)
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Delete removes the resource from the Terraform state. The workflow keeps
+// the settings last applied, as their values before the resource was created
+// are unknown, so nothing is sent to n8n.
+func (r *workflowSettingsResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state workflowSettingsResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Info(ctx, "Removing workflow settings from state, the workflow keeps its settings", map[string]any{
+		"workflow_id": state.WorkflowID.ValueString(),
+	})
+}
+
+// ImportState imports the settings of an existing workflow by its ID.
+func (r *workflowSettingsResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), req.ID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("workflow_id"), req.ID)...)
+}
+
+// addWorkflowNotFoundError reports that the workflow whose settings are
+// managed does not exist.
+func addWorkflowNotFoundError(id string, diags *diag.Diagnostics) {
+	diags.AddAttributeError(
+		path.Root("workflow_id"),
+		"Workflow Not Found",
+		fmt.Sprintf("No workflow with ID %q was found.", id),
+	)
+}
+
+// apply copies the known settings of the model into settings.
+func (m workflowSettingsResourceModel) apply(settings *n8n.Settings) {
+	if isKnown(m.SaveExecutionProgress) {
+		settings.SaveExecutionProgress = m.SaveExecutionProgress.ValueBool()
+	}
+	if isKnown(m.SaveManualExecutions) {
+		settings.SaveManualExecutions = m.SaveManualExecutions.ValueBool()
+	}
+	if isKnown(m.SaveDataErrorExecution) {
+		settings.SaveDataErrorExecution = m.SaveDataErrorExecution.ValueString()
+	}
+	if isKnown(m.SaveDataSuccessExecution) {
+		settings.SaveDataSuccessExecution = m.SaveDataSuccessExecution.ValueString()
+	}
+	if isKnown(m.ExecutionTimeout) {
+		settings.ExecutionTimeout = int(m.ExecutionTimeout.ValueInt64())
+	}
+	if isKnown(m.ErrorWorkflow) {
+		settings.ErrorWorkflow = m.ErrorWorkflow.ValueString()
+	}
+	if isKnown(m.Timezone) {
+		settings.Timezone = m.Timezone.ValueString()
+	}
+	if isKnown(m.ExecutionOrder) {
+		settings.ExecutionOrder = m.ExecutionOrder.ValueString()
+	}
+}
+
+// setSettings copies the settings returned by n8n into the model.
+func (m *workflowSettingsResourceModel) setSettings(settings n8n.Settings) {
+	m.SaveExecutionProgress = types.BoolValue(settings.SaveExecutionProgress)
+	m.SaveManualExecutions = types.BoolValue(settings.SaveManualExecutions)
+	m.SaveDataErrorExecution = types.StringValue(settings.SaveDataErrorExecution)
+	m.SaveDataSuccessExecution = types.StringValue(settings.SaveDataSuccessExecution)
+	m.ExecutionTimeout = types.Int64Value(int64(settings.ExecutionTimeout))
+	m.ErrorWorkflow = types.StringValue(settings.ErrorWorkflow)
+	m.Timezone = types.StringValue(settings.Timezone)
+	m.ExecutionOrder = types.StringValue(settings.ExecutionOrder)
+}
+
+// isKnown reports whether a value is neither null nor unknown.
+func isKnown(value interface {
+	IsNull() bool
+	IsUnknown() bool
+}) bool {
+	return !value.IsNull() && !value.IsUnknown()
+}
@@ -0,0 +1,185 @@
+// Copyright (c) Arthur Diniz <arthurbdiniz@gmail.com>
+// SPDX-License-Identifier: Apache-2.0
+
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/arthurbdiniz/terraform-provider-n8n/internal/config"
+	"github.com/arthurbdiniz/terraform-provider-n8n/internal/pkg/n8n-client-go"
+	"github.com/arthurbdiniz/terraform-provider-n8n/internal/pkg/n8n-client-go/n8ntest"
+	fwresource "github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/stretchr/testify/require"
+)
+
+func TestWorkflowSettingsResource(t *testing.T) {
+	server := newTestServer(t)
+	client, err := n8n.NewClient(&server.URL, &config.ApiToken)
+	require.NoError(t, err)
+
+	nodes := `[{"id":"1","name":"Webhook","type":"n8n-nodes-base.webhook","typeVersion":2,"position":[0,0],"parameters":{"path":"orders"}}]`
+	connections := `{"Webhook":{"main":[[]]}}`
+	workflow := server.AddWorkflow(n8ntest.Workflow{
+		Name:        "Orders",
+		Nodes:       json.RawMessage(nodes),
+		Connections: json.RawMessage(connections),
+		Settings:    json.RawMessage(`{"executionOrder":"v1","timezone":"UTC","callerPolicy":"workflowsFromSameOwner"}`),
+	})
+
+	configuration := fmt.Sprintf(`
+		resource "n8n_workflow_settings" "test" {
+			workflow_id               = %q
+			error_workflow            = "VzqKEW0ShTXA5vPj"
+			execution_timeout         = 300
+			save_data_error_execution = "all"
+		}
+	`, workflow.ID)
+
+	checkRemote := func(expected string) resource.TestCheckFunc {
+		return func(*terraform.State) error {
+			stored, ok := server.Workflow(workflow.ID)
+			if !ok {
+				return fmt.Errorf("workflow %s not found", workflow.ID)
+			}
+			require.JSONEq(t, nodes, string(stored.Nodes), "nodes must be left untouched")
+			require.JSONEq(t, connections, string(stored.Connections), "connections must be left untouched")
+			require.JSONEq(t, expected, string(stored.Settings))
+			return nil
+		}
+	}
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testProtoV6ProviderFactoriesForURL(t, server.URL),
+		CheckDestroy: func(*terraform.State) error {
+			stored, ok := server.Workflow(workflow.ID)
+			require.True(t, ok, "destroy must not delete the workflow")
+			require.Contains(t, string(stored.Settings), "VzqKEW0ShTXA5vPj", "destroy must leave the settings unchanged")
+			return nil
+		},
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: configuration,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("n8n_workflow_settings.test", "id", workflow.ID),
+					resource.TestCheckResourceAttr("n8n_workflow_settings.test", "error_workflow", "VzqKEW0ShTXA5vPj"),
+					resource.TestCheckResourceAttr("n8n_workflow_settings.test", "execution_timeout", "300"),
+					resource.TestCheckResourceAttr("n8n_workflow_settings.test", "save_data_error_execution", "all"),
+					resource.TestCheckResourceAttr("n8n_workflow_settings.test", "timezone", "UTC"),
+					resource.TestCheckResourceAttr("n8n_workflow_settings.test", "execution_order", "v1"),
+					checkRemote(`{
+						"saveExecutionProgress": false,
+						"saveManualExecutions": false,
+						"saveDataErrorExecution": "all",
+						"executionTimeout": 300,
+						"errorWorkflow": "VzqKEW0ShTXA5vPj",
+						"timezone": "UTC",
+						"executionOrder": "v1",
+						"callerPolicy": "workflowsFromSameOwner"
+					}`),
+				),
+			},
+			// Changes to unmanaged settings are not drift
+			{
+				PreConfig: func() {
+					_, err := client.UpdateWorkflowSettings(workflow.ID, func(settings *n8n.Settings) {
+						settings.Timezone = "Europe/Berlin"
+					})
+					require.NoError(t, err)
+				},
+				Config:   configuration,
+				PlanOnly: true,
+			},
+			// Changes to managed settings are drift
+			{
+				PreConfig: func() {
+					_, err := client.UpdateWorkflowSettings(workflow.ID, func(settings *n8n.Settings) {
+						settings.ErrorWorkflow = "AnotherWorkflow1"
+					})
+					require.NoError(t, err)
+				},
+				Config:             configuration,
+				PlanOnly:           true,
+				ExpectNonEmptyPlan: true,
+			},
+			// Apply restores the managed settings
+			{
+				Config: configuration,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("n8n_workflow_settings.test", "error_workflow", "VzqKEW0ShTXA5vPj"),
+					resource.TestCheckResourceAttr("n8n_workflow_settings.test", "timezone", "Europe/Berlin"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:      "n8n_workflow_settings.test",
+				ImportState:       true,
+				ImportStateId:     workflow.ID,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestWorkflowSettingsResource_WorkflowNotFound(t *testing.T) {
+	server := newTestServer(t)
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testProtoV6ProviderFactoriesForURL(t, server.URL),
+		Steps: []resource.TestStep{
+			{
+				Config: `
+					resource "n8n_workflow_settings" "test" {
+						workflow_id = "missing"
+						timezone    = "UTC"
+					}
+				`,
+				ExpectError: regexp.MustCompile(`No workflow with ID "missing" was found`),
+			},
+		},
+	})
+}
+
+func TestWorkflowSettingsResource_UpdateDeletedWorkflow(t *testing.T) {
+	server := newTestServer(t)
+	client, err := n8n.NewClient(&server.URL, &config.ApiToken)
+	require.NoError(t, err)
+
+	ctx := context.Background()
+	r := &workflowSettingsResource{client: client}
+
+	var schemaResp fwresource.SchemaResponse
+	r.Schema(ctx, fwresource.SchemaRequest{}, &schemaResp)
+	require.False(t, schemaResp.Diagnostics.HasError())
+
+	// The workflow was deleted between the refresh and the apply.
+	objectType := schemaResp.Schema.Type().TerraformType(ctx).(tftypes.Object)
+	attributes := make(map[string]tftypes.Value, len(objectType.AttributeTypes))
+	for name, attributeType := range objectType.AttributeTypes {
+		attributes[name] = tftypes.NewValue(attributeType, nil)
+	}
+	attributes["id"] = tftypes.NewValue(tftypes.String, "deleted")
+	attributes["workflow_id"] = tftypes.NewValue(tftypes.String, "deleted")
+	attributes["timezone"] = tftypes.NewValue(tftypes.String, "UTC")
+	raw := tftypes.NewValue(objectType, attributes)
+
+	req := fwresource.UpdateRequest{
+		Plan:  tfsdk.Plan{Schema: schemaResp.Schema, Raw: raw},
+		State: tfsdk.State{Schema: schemaResp.Schema, Raw: raw},
+	}
+	resp := &fwresource.UpdateResponse{State: tfsdk.State{Schema: schemaResp.Schema, Raw: raw.Copy()}}
+	r.Update(ctx, req, resp)
+
+	require.True(t, resp.Diagnostics.HasError())
+	require.Equal(t, "Workflow Not Found", resp.Diagnostics.Errors()[0].Summary())
+	require.True(t, resp.State.Raw.IsNull(), "a deleted workflow must be removed from the state")
+}
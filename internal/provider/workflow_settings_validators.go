@@ -0,0 +1,129 @@
+// Copyright (c) Arthur Diniz <arthurbdiniz@gmail.com>
+// SPDX-License-Identifier: Apache-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"slices"
+	"time"
+
+	// Embed the IANA time zone database so timezones validate the same way
+	// on machines without one installed.
+	_ "time/tzdata"
+
+	"github.com/arthurbdiniz/terraform-provider-n8n/internal/pkg/n8n-client-go"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+)
+
+// Allowed values of the workflow settings, as documented by the n8n public API.
+var (
+	saveDataValues       = []string{"all", "none"}
+	executionOrderValues = []string{"v0", "v1"}
+)
+
+const (
+	// maxExecutionTimeout is the longest execution timeout n8n accepts, in seconds.
+	maxExecutionTimeout = 3600
+
+	// noExecutionTimeout disables the execution timeout.
+	noExecutionTimeout = -1
+)
+
+// workflowIDPattern matches the IDs n8n assigns to workflows: 16 alphanumeric
+// characters, or a number on instances created before n8n 1.0.
+var workflowIDPattern = regexp.MustCompile(`^([0-9A-Za-z]{16}|[0-9]+)$`)
+
+func saveDataValidators() []validator.String {
+	return []validator.String{stringvalidator.OneOf(saveDataValues...)}
+}
+
+func executionOrderValidators() []validator.String {
+	return []validator.String{stringvalidator.OneOf(executionOrderValues...)}
+}
+
+func executionTimeoutValidators() []validator.Int64 {
+	return []validator.Int64{
+		int64validator.Any(
+			int64validator.OneOf(noExecutionTimeout),
+			int64validator.Between(1, maxExecutionTimeout),
+		),
+	}
+}
+
+func workflowIDValidators() []validator.String {
+	return []validator.String{
+		stringvalidator.RegexMatches(workflowIDPattern, "must be a workflow ID, such as VzqKEW0ShTXA5vPj"),
+	}
+}
+
+func timezoneValidators() []validator.String {
+	return []validator.String{timezoneValidator{}}
+}
+
+// timezoneValidator checks that a string is a name from the IANA time zone database.
+type timezoneValidator struct{}
+
+func (v timezoneValidator) Description(_ context.Context) string {
+	return "value must be an IANA time zone name, such as Europe/Berlin"
+}
+
+func (v timezoneValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v timezoneValidator) ValidateString(ctx context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	if err := validateTimezone(req.ConfigValue.ValueString()); err != nil {
+		resp.Diagnostics.AddAttributeError(req.Path, "Invalid Timezone", err.Error())
+	}
+}
+
+func validateTimezone(timezone string) error {
+	// time.LoadLocation also accepts "" and "Local", which n8n does not.
+	if timezone == "" || timezone == "Local" {
+		return fmt.Errorf("%q is not an IANA time zone name, such as Europe/Berlin", timezone)
+	}
+	if _, err := time.LoadLocation(timezone); err != nil {
+		return fmt.Errorf("%q is not an IANA time zone name, such as Europe/Berlin", timezone)
+	}
+	return nil
+}
+
+// validateWorkflowSettings applies the same rules as the n8n_workflow_settings
+// validators to settings decoded from a workflow JSON document, returning one
+// message per invalid setting. Empty settings are left to n8n defaults and
+// are not checked.
+func validateWorkflowSettings(settings n8n.Settings) []string {
+	var problems []string
+
+	if value := settings.SaveDataErrorExecution; value != "" && !slices.Contains(saveDataValues, value) {
+		problems = append(problems, fmt.Sprintf("settings.saveDataErrorExecution must be one of %q, got %q", saveDataValues, value))
+	}
+	if value := settings.SaveDataSuccessExecution; value != "" && !slices.Contains(saveDataValues, value) {
+		problems = append(problems, fmt.Sprintf("settings.saveDataSuccessExecution must be one of %q, got %q", saveDataValues, value))
+	}
+	if value := settings.ExecutionOrder; value != "" && !slices.Contains(executionOrderValues, value) {
+		problems = append(problems, fmt.Sprintf("settings.executionOrder must be one of %q, got %q", executionOrderValues, value))
+	}
+	if value := settings.ExecutionTimeout; value != 0 && value != noExecutionTimeout && (value < 1 || value > maxExecutionTimeout) {
+		problems = append(problems, fmt.Sprintf("settings.executionTimeout must be %d or between 1 and %d seconds, got %d", noExecutionTimeout, maxExecutionTimeout, value))
+	}
+	if value := settings.Timezone; value != "" {
+		if err := validateTimezone(value); err != nil {
+			problems = append(problems, "settings.timezone: "+err.Error())
+		}
+	}
+	if value := settings.ErrorWorkflow; value != "" && !workflowIDPattern.MatchString(value) {
+		problems = append(problems, fmt.Sprintf("settings.errorWorkflow must be a workflow ID, such as VzqKEW0ShTXA5vPj, got %q", value))
+	}
+
+	return problems
+}
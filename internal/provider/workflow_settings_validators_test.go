@@ -0,0 +1,146 @@
+// Copyright (c) Arthur Diniz <arthurbdiniz@gmail.com>
+// SPDX-License-Identifier: Apache-2.0
+
+package provider
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"regexp"
+	"testing"
+
+	"github.com/arthurbdiniz/terraform-provider-n8n/internal/pkg/n8n-client-go"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTimezoneValidator(t *testing.T) {
+	tests := map[string]struct {
+		value   types.String
+		isValid bool
+	}{
+		"iana name":   {value: types.StringValue("Europe/Berlin"), isValid: true},
+		"utc":         {value: types.StringValue("UTC"), isValid: true},
+		"null":        {value: types.StringNull(), isValid: true},
+		"unknown":     {value: types.StringUnknown(), isValid: true},
+		"abbreviated": {value: types.StringValue("Berlin"), isValid: false},
+		"offset":      {value: types.StringValue("+02:00"), isValid: false},
+		"local":       {value: types.StringValue("Local"), isValid: false},
+		"empty":       {value: types.StringValue(""), isValid: false},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			req := validator.StringRequest{Path: path.Root("timezone"), ConfigValue: tc.value}
+			resp := &validator.StringResponse{}
+
+			timezoneValidator{}.ValidateString(context.Background(), req, resp)
+
+			assert.Equal(t, !tc.isValid, resp.Diagnostics.HasError(), resp.Diagnostics)
+		})
+	}
+}
+
+func TestValidateWorkflowSettings(t *testing.T) {
+	assert.Empty(t, validateWorkflowSettings(n8n.Settings{}))
+	assert.Empty(t, validateWorkflowSettings(n8n.Settings{
+		SaveDataErrorExecution:   "all",
+		SaveDataSuccessExecution: "none",
+		ExecutionOrder:           "v1",
+		ExecutionTimeout:         3600,
+		Timezone:                 "America/New_York",
+		ErrorWorkflow:            "VzqKEW0ShTXA5vPj",
+	}))
+	assert.Empty(t, validateWorkflowSettings(n8n.Settings{ExecutionTimeout: -1, ErrorWorkflow: "42"}))
+
+	problems := validateWorkflowSettings(n8n.Settings{
+		SaveDataErrorExecution:   "always",
+		SaveDataSuccessExecution: "some",
+		ExecutionOrder:           "v2",
+		ExecutionTimeout:         7200,
+		Timezone:                 "Mars/Olympus",
+		ErrorWorkflow:            "Error Handler",
+	})
+	assert.Equal(t, []string{
+		`settings.saveDataErrorExecution must be one of ["all" "none"], got "always"`,
+		`settings.saveDataSuccessExecution must be one of ["all" "none"], got "some"`,
+		`settings.executionOrder must be one of ["v0" "v1"], got "v2"`,
+		`settings.executionTimeout must be -1 or between 1 and 3600 seconds, got 7200`,
+		`settings.timezone: "Mars/Olympus" is not an IANA time zone name, such as Europe/Berlin`,
+		`settings.errorWorkflow must be a workflow ID, such as VzqKEW0ShTXA5vPj, got "Error Handler"`,
+	}, problems)
+}
+
+// newUnreachableAPI returns a server failing the test on any request, to
+// check that invalid configurations are rejected before calling n8n.
+func newUnreachableAPI(t *testing.T) *httptest.Server {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		t.Errorf("unexpected request %s %s", r.Method, r.URL)
+		w.WriteHeader(http.StatusInternalServerError)
+	}))
+	t.Cleanup(server.Close)
+	return server
+}
+
+func TestWorkflowSettingsResource_Validation(t *testing.T) {
+	tests := map[string]struct {
+		attribute string
+		expected  string
+	}{
+		"save data enum":       {`save_data_error_execution = "always"`, `value must be one of: \["all" "none"\]`},
+		"execution order enum": {`execution_order = "v2"`, `value must be one of: \["v0" "v1"\]`},
+		"timeout too long":     {`execution_timeout = 3601`, `execution_timeout`},
+		"timeout zero":         {`execution_timeout = 0`, `execution_timeout`},
+		"unknown timezone":     {`timezone = "Europe/Atlantis"`, `"Europe/Atlantis" is not an IANA time zone name`},
+		"error workflow name":  {`error_workflow = "Alerting"`, `must be a workflow ID`},
+	}
+
+	server := newUnreachableAPI(t)
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			resource.UnitTest(t, resource.TestCase{
+				ProtoV6ProviderFactories: testProtoV6ProviderFactoriesForURL(t, server.URL),
+				Steps: []resource.TestStep{
+					{
+						Config: `
+							resource "n8n_workflow_settings" "test" {
+								workflow_id = "VzqKEW0ShTXA5vPj"
+								` + tc.attribute + `
+							}
+						`,
+						PlanOnly:    true,
+						ExpectError: regexp.MustCompile(tc.expected),
+					},
+				},
+			})
+		})
+	}
+}
+
+func TestWorkflowResource_SettingsValidation(t *testing.T) {
+	server := newUnreachableAPI(t)
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testProtoV6ProviderFactoriesForURL(t, server.URL),
+		Steps: []resource.TestStep{
+			{
+				Config: `
+					resource "n8n_workflow" "test" {
+						workflow_json = jsonencode({
+							name        = "Invalid Settings"
+							nodes       = []
+							connections = {}
+							settings    = { executionOrder = "v1", executionTimeout = 7200, timezone = "UTC+2" }
+						})
+					}
+				`,
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile(`(?s)executionTimeout must be -1 or between 1 and 3600.*"UTC\+2" is not an IANA`),
+			},
+		},
+	})
+}
@@ -0,0 +1,102 @@
+// Copyright (c) Arthur Diniz <arthurbdiniz@gmail.com>
+// SPDX-License-Identifier: Apache-2.0
+
+package provider
+
+import (
+	"strings"
+
+	"github.com/arthurbdiniz/terraform-provider-n8n/internal/pkg/n8n-client-go"
+)
+
+// webhookEndpoints are the URL path prefixes under which n8n serves the
+// webhooks of a node type, with its default endpoint settings.
+type webhookEndpoints struct {
+	Production string
+	Test       string
+
+	// Method is the HTTP method of nodes without an httpMethod parameter.
+	Method string
+}
+
+// webhookNodeTypes are the trigger node types registering a webhook.
+var webhookNodeTypes = map[string]webhookEndpoints{
+	"n8n-nodes-base.webhook":     {Production: "webhook", Test: "webhook-test", Method: "GET"},
+	"n8n-nodes-base.formTrigger": {Production: "form", Test: "form-test", Method: "GET"},
+}
+
+// workflowWebhook is a webhook registered by a trigger node.
+type workflowWebhook struct {
+	Node          string
+	Method        string
+	Path          string
+	ProductionURL string
+	TestURL       string
+}
+
+// workflowWebhooks returns the webhooks registered by the enabled Webhook and
+// Form Trigger nodes of workflow, in node order, with their URLs below
+// hostURL. A node accepting several HTTP methods returns a webhook for each.
+func workflowWebhooks(hostURL string, workflow *n8n.Workflow) []workflowWebhook {
+	var webhooks []workflowWebhook
+	for _, node := range workflow.Nodes {
+		endpoints, ok := webhookNodeTypes[node.Type]
+		if !ok || node.Disabled {
+			continue
+		}
+
+		webhookPath := nodeWebhookPath(node)
+		if webhookPath == "" {
+			continue
+		}
+
+		for _, method := range nodeWebhookMethods(node, endpoints.Method) {
+			webhooks = append(webhooks, workflowWebhook{
+				Node:          node.Name,
+				Method:        method,
+				Path:          webhookPath,
+				ProductionURL: hostURL + "/" + endpoints.Production + "/" + webhookPath,
+				TestURL:       hostURL + "/" + endpoints.Test + "/" + webhookPath,
+			})
+		}
+	}
+	return webhooks
+}
+
+// nodeWebhookPath returns the path of the webhook registered by node, like
+// n8n: the path parameter, defaulting to the webhook ID, with paths holding
+// route parameters such as :id prefixed by the webhook ID.
+func nodeWebhookPath(node n8n.Node) string {
+	webhookPath, _ := node.Parameters["path"].(string)
+	webhookPath = strings.Trim(webhookPath, "/")
+	if webhookPath == "" {
+		return node.WebhookID
+	}
+	if node.WebhookID != "" && strings.Contains(webhookPath, ":") {
+		return node.WebhookID + "/" + webhookPath
+	}
+	return webhookPath
+}
+
+// nodeWebhookMethods returns the HTTP methods accepted by the webhook of
+// node, from its httpMethod parameter, holding a list when the node accepts
+// multiple methods.
+func nodeWebhookMethods(node n8n.Node, defaultMethod string) []string {
+	switch value := node.Parameters["httpMethod"].(type) {
+	case string:
+		if value != "" {
+			return []string{value}
+		}
+	case []interface{}:
+		var methods []string
+		for _, method := range value {
+			if method, ok := method.(string); ok && method != "" {
+				methods = append(methods, method)
+			}
+		}
+		if len(methods) > 0 {
+			return methods
+		}
+	}
+	return []string{defaultMethod}
+}
@@ -0,0 +1,43 @@
+// Copyright (c) Arthur Diniz <arthurbdiniz@gmail.com>
+// SPDX-License-Identifier: Apache-2.0
+
+package provider
+
+import (
+	"testing"
+
+	"github.com/arthurbdiniz/terraform-provider-n8n/internal/pkg/n8n-client-go"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestWorkflowWebhooks(t *testing.T) {
+	workflow := &n8n.Workflow{
+		Nodes: []n8n.Node{
+			{Name: "Orders", Type: "n8n-nodes-base.webhook", WebhookID: "a1", Parameters: map[string]interface{}{"path": "/orders", "httpMethod": "POST"}},
+			{Name: "Default", Type: "n8n-nodes-base.webhook", WebhookID: "b2", Parameters: map[string]interface{}{}},
+			{Name: "Order", Type: "n8n-nodes-base.webhook", WebhookID: "c3", Parameters: map[string]interface{}{"path": "orders/:id", "httpMethod": []interface{}{"GET", "DELETE"}, "multipleMethods": true}},
+			{Name: "Signup", Type: "n8n-nodes-base.formTrigger", WebhookID: "d4", Parameters: map[string]interface{}{"path": "signup"}},
+			{Name: "Disabled", Type: "n8n-nodes-base.webhook", WebhookID: "e5", Disabled: true, Parameters: map[string]interface{}{"path": "old"}},
+			{Name: "Set", Type: "n8n-nodes-base.set", WebhookID: "f6", Parameters: map[string]interface{}{"path": "set"}},
+		},
+	}
+
+	assert.Equal(t, []workflowWebhook{
+		{Node: "Orders", Method: "POST", Path: "orders", ProductionURL: "https://n8n.example.com/webhook/orders", TestURL: "https://n8n.example.com/webhook-test/orders"},
+		{Node: "Default", Method: "GET", Path: "b2", ProductionURL: "https://n8n.example.com/webhook/b2", TestURL: "https://n8n.example.com/webhook-test/b2"},
+		{Node: "Order", Method: "GET", Path: "c3/orders/:id", ProductionURL: "https://n8n.example.com/webhook/c3/orders/:id", TestURL: "https://n8n.example.com/webhook-test/c3/orders/:id"},
+		{Node: "Order", Method: "DELETE", Path: "c3/orders/:id", ProductionURL: "https://n8n.example.com/webhook/c3/orders/:id", TestURL: "https://n8n.example.com/webhook-test/c3/orders/:id"},
+		{Node: "Signup", Method: "GET", Path: "signup", ProductionURL: "https://n8n.example.com/form/signup", TestURL: "https://n8n.example.com/form-test/signup"},
+	}, workflowWebhooks("https://n8n.example.com", workflow))
+}
+
+func TestWorkflowWebhooks_None(t *testing.T) {
+	workflow := &n8n.Workflow{
+		Nodes: []n8n.Node{
+			{Name: "Webhook", Type: "n8n-nodes-base.webhook", Parameters: map[string]interface{}{}},
+			{Name: "Start", Type: "n8n-nodes-base.manualTrigger"},
+		},
+	}
+
+	assert.Empty(t, workflowWebhooks("https://n8n.example.com", workflow))
+}
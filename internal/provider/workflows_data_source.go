@@ -10,8 +10,7 @@ import (
 	"github.com/arthurbdiniz/terraform-provider-n8n/internal/pkg/n8n-client-go"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
-	"github.com/hashicorp/terraform-plugin-framework/types"
-	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 )
 
 // Ensure the implementation satisfies the expected interfaces.
@@ -32,57 +31,7 @@ type workflowsDataSource struct {
 
 // workflowsDataSourceModel maps the data source schema data.
 type workflowsDataSourceModel struct {
-	Workflows []workflowsModel `tfsdk:"workflows"`
-}
-
-// workflowsModel maps workflows schema data.
-type workflowsModel struct {
-	ID           types.String   `tfsdk:"id"`
-	Name         types.String   `tfsdk:"name"`
-	Active       types.Bool     `tfsdk:"active"`
-	VersionId    types.String   `tfsdk:"version_id"`
-	TriggerCount types.Int64    `tfsdk:"trigger_count"`
-	CreatedAt    types.String   `tfsdk:"created_at"`
-	UpdatedAt    types.String   `tfsdk:"updated_at"`
-	Nodes        []nodesModel   `tfsdk:"nodes"`
-	Connections  types.String   `tfsdk:"connections"`
-	Settings     *settingsModel `tfsdk:"settings"`
-	Tags         []tagsModel    `tfsdk:"tags"`
-	// PinData      types.Map      `tfsdk:"pin_data"`
-	// StaticData   types.Map      `tfsdk:"static_data"`
-}
-
-type nodesModel struct {
-	ID          types.String     `tfsdk:"id"`
-	Name        types.String     `tfsdk:"name"`
-	Type        types.String     `tfsdk:"type"`
-	TypeVersion types.Float64    `tfsdk:"type_version"`
-	Position    []types.Int64    `tfsdk:"position"`
-	Parameters  []parameterModel `tfsdk:"parameters"`
-}
-
-type tagsModel struct {
-	CreatedAt types.String `tfsdk:"created_at"`
-	UpdatedAt types.String `tfsdk:"updated_at"`
-	ID        types.String `tfsdk:"id"`
-	Name      types.String `tfsdk:"name"`
-}
-
-type parameterModel struct {
-	Key   types.String `tfsdk:"key"`
-	Type  types.String `tfsdk:"type"`
-	Value types.String `tfsdk:"value"`
-}
-
-type settingsModel struct {
-	SaveExecutionProgress    types.Bool   `tfsdk:"save_execution_progress"`
-	SaveManualExecutions     types.Bool   `tfsdk:"save_manual_executions"`
-	SaveDataErrorExecution   types.String `tfsdk:"save_data_error_execution"`
-	SaveDataSuccessExecution types.String `tfsdk:"save_data_success_execution"`
-	ExecutionTimeout         types.Int64  `tfsdk:"execution_timeout"`
-	ErrorWorkflow            types.String `tfsdk:"error_workflow"`
-	Timezone                 types.String `tfsdk:"timezone"`
-	ExecutionOrder           types.String `tfsdk:"execution_order"`
+	Workflows []workflowModel `tfsdk:"workflows"`
 }
 
 // Configure adds the provider configured client to the data source.
@@ -151,11 +100,30 @@ func (d *workflowsDataSource) Schema(_ context.Context, _ datasource.SchemaReque
 						},
 						"nodes": workflowsNodeAttr(),
 						"connections": schema.StringAttribute{
+							CustomType:  WorkflowJSONType{},
 							Computed:    true,
 							Description: "Raw JSON representation of connections between nodes.",
 						},
 						"settings": workflowsSettingsAttr(),
 						"tags":     workflowsTagsAttr(),
+						"webhooks": workflowsWebhooksAttr(),
+						"pin_data": schema.StringAttribute{
+							Computed:    true,
+							Description: "Raw JSON representation of the data pinned to nodes, keyed by node name.",
+						},
+						"static_data": schema.StringAttribute{
+							Computed:    true,
+							Description: "Raw JSON representation of the data persisted by the workflow between executions.",
+						},
+						"meta": schema.StringAttribute{
+							Computed:    true,
+							Description: "Raw JSON representation of the workflow editor metadata.",
+						},
+						"workflow_json": schema.StringAttribute{
+							CustomType:  WorkflowJSONType{},
+							Computed:    true,
+							Description: "The workflow as a JSON document without instance-specific fields, like a workflow exported from the n8n editor.",
+						},
 					},
 				},
 			},
@@ -165,9 +133,13 @@ func (d *workflowsDataSource) Schema(_ context.Context, _ datasource.SchemaReque
 
 // Read refreshes the Terraform state with the latest data.
 func (d *workflowsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	if !checkClient(d.client, &resp.Diagnostics) {
+		return
+	}
+
 	var state workflowsDataSourceModel
 
-	workflowsResponse, err := d.client.GetWorkflows()
+	workflowsResponse, err := d.client.WithContext(ctx).GetWorkflows()
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Unable to Read n8n Workflows",
@@ -177,80 +149,14 @@ func (d *workflowsDataSource) Read(ctx context.Context, req datasource.ReadReque
 	}
 
 	// Map response body to model
-	for _, workflow := range workflowsResponse.Data {
-		// Convert nodes
-		var nodes []nodesModel
-
-		for _, node := range workflow.Nodes {
-			var positions []types.Int64
-			for _, pos := range node.Position {
-				positions = append(positions, types.Int64Value(int64(pos)))
-			}
-
-			params, err := ConvertToTerraformList(node.Parameters)
-			if err != nil {
-				tflog.Error(ctx, "Error converting parameters", map[string]interface{}{
-					"error": err.Error(),
-				})
-				continue // Skip this node if there's an error
-			}
-
-			nodes = append(nodes, nodesModel{
-				ID:          types.StringValue(node.ID),
-				Name:        types.StringValue(node.Name),
-				Type:        types.StringValue(node.Type),
-				TypeVersion: types.Float64Value(float64(node.TypeVersion)),
-				Parameters:  params,
-				Position:    positions,
-			})
-		}
-
-		// Convert tags
-		var tags []tagsModel
-		for _, tag := range workflow.Tags {
-			tags = append(tags, tagsModel{
-				CreatedAt: types.StringValue(tag.CreatedAt),
-				UpdatedAt: types.StringValue(tag.UpdatedAt),
-				ID:        types.StringValue(tag.ID),
-				Name:      types.StringValue(tag.Name),
-			})
-		}
-
-		// Convert connections
-		connectionsJSON, err := ConvertConnectionsToTerraformMap(workflow.Connections)
-		if err != nil {
-			resp.Diagnostics.AddError(
-				"Unable to process workflow connections",
-				err.Error(),
-			)
-			return
-		}
-
-		workflowState := workflowsModel{
-			ID:           types.StringValue(workflow.ID),
-			Name:         types.StringValue(workflow.Name),
-			Active:       types.BoolValue(workflow.Active),
-			VersionId:    types.StringValue(workflow.VersionId),
-			TriggerCount: types.Int64Value(int64(workflow.TriggerCount)),
-			CreatedAt:    types.StringValue(workflow.CreatedAt),
-			UpdatedAt:    types.StringValue(workflow.UpdatedAt),
-			Nodes:        nodes,
-			Connections:  connectionsJSON,
-			Settings: &settingsModel{
-				SaveExecutionProgress:    types.BoolValue(workflow.Settings.SaveExecutionProgress),
-				SaveManualExecutions:     types.BoolValue(workflow.Settings.SaveManualExecutions),
-				SaveDataErrorExecution:   types.StringValue(workflow.Settings.SaveDataErrorExecution),
-				SaveDataSuccessExecution: types.StringValue(workflow.Settings.SaveDataSuccessExecution),
-				ExecutionTimeout:         types.Int64Value(int64(workflow.Settings.ExecutionTimeout)),
-				ErrorWorkflow:            types.StringValue(workflow.Settings.ErrorWorkflow),
-				Timezone:                 types.StringValue(workflow.Settings.Timezone),
-				ExecutionOrder:           types.StringValue(workflow.Settings.ExecutionOrder),
-			},
-			Tags: tags,
-		}
-
+	for i := range workflowsResponse.Data {
+		workflowState, diags := newWorkflowModel(path.Root("workflows").AtListIndex(i), &workflowsResponse.Data[i], d.client.HostURL)
+		resp.Diagnostics.Append(diags...)
 		state.Workflows = append(state.Workflows, workflowState)
 	}
+	if resp.Diagnostics.HasError() {
+		return
+	}
 
 	// Set state
 	diags := resp.State.Set(ctx, &state)
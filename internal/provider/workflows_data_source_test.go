@@ -5,11 +5,14 @@ package provider
 
 import (
 	"fmt"
+	"net/http"
+	"regexp"
 	"testing"
 
 	"github.com/arthurbdiniz/terraform-provider-n8n/internal/config"
 	"github.com/arthurbdiniz/terraform-provider-n8n/internal/helpers"
 	"github.com/arthurbdiniz/terraform-provider-n8n/internal/pkg/n8n-client-go"
+	"github.com/arthurbdiniz/terraform-provider-n8n/internal/pkg/n8n-client-go/n8ntest"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/stretchr/testify/require"
 )
@@ -34,7 +37,7 @@ func TestWorkflowsDataSource(t *testing.T) {
 				Name:        "Start",
 				Type:        "n8n-nodes-base.start",
 				TypeVersion: 1,
-				Position:    []int{0, 0},
+				Position:    []float64{0, 0},
 				Parameters:  map[string]interface{}{},
 			},
 		},
@@ -99,10 +102,88 @@ func TestWorkflowsDataSource(t *testing.T) {
 					resource.TestCheckResourceAttr("data.n8n_workflows.test", "workflows.0.nodes.0.name", createdWorkflow.Nodes[0].Name),
 					resource.TestCheckResourceAttr("data.n8n_workflows.test", "workflows.0.nodes.0.type", createdWorkflow.Nodes[0].Type),
 					resource.TestCheckResourceAttr("data.n8n_workflows.test", "workflows.0.nodes.0.type_version", fmt.Sprintf("%g", createdWorkflow.Nodes[0].TypeVersion)),
-					resource.TestCheckResourceAttr("data.n8n_workflows.test", "workflows.0.nodes.0.position.0", fmt.Sprintf("%d", createdWorkflow.Nodes[0].Position[0])),
-					resource.TestCheckResourceAttr("data.n8n_workflows.test", "workflows.0.nodes.0.position.1", fmt.Sprintf("%d", createdWorkflow.Nodes[0].Position[1])),
+					resource.TestCheckResourceAttr("data.n8n_workflows.test", "workflows.0.nodes.0.position.0", fmt.Sprintf("%g", createdWorkflow.Nodes[0].Position[0])),
+					resource.TestCheckResourceAttr("data.n8n_workflows.test", "workflows.0.nodes.0.position.1", fmt.Sprintf("%g", createdWorkflow.Nodes[0].Position[1])),
+					resource.TestCheckResourceAttr("data.n8n_workflows.test", "workflows.0.nodes.0.parameters_json", "{}"),
 				),
 			},
 		},
 	})
 }
+
+func TestWorkflowsDataSource_Pagination(t *testing.T) {
+	server := newTestServer(t)
+	server.PageSize = 2
+	for _, name := range []string{"One", "Two", "Three", "Four", "Five"} {
+		server.AddWorkflow(n8ntest.Workflow{Name: name})
+	}
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testProtoV6ProviderFactoriesForURL(t, server.URL),
+		Steps: []resource.TestStep{
+			{
+				Config: `data "n8n_workflows" "test" {}`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.n8n_workflows.test", "workflows.#", "5"),
+					resource.TestCheckResourceAttr("data.n8n_workflows.test", "workflows.0.name", "One"),
+					resource.TestCheckResourceAttr("data.n8n_workflows.test", "workflows.2.name", "Three"),
+					resource.TestCheckResourceAttr("data.n8n_workflows.test", "workflows.4.name", "Five"),
+				),
+			},
+		},
+	})
+}
+
+func TestWorkflowsDataSource_Empty(t *testing.T) {
+	server := newTestServer(t)
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testProtoV6ProviderFactoriesForURL(t, server.URL),
+		Steps: []resource.TestStep{
+			{
+				Config: `data "n8n_workflows" "test" {}`,
+				Check:  resource.TestCheckResourceAttr("data.n8n_workflows.test", "workflows.#", "0"),
+			},
+		},
+	})
+}
+
+func TestWorkflowsDataSource_MalformedResponse(t *testing.T) {
+	tests := map[string]struct {
+		status int
+		body   string
+		error  string
+	}{
+		"truncated JSON": {
+			status: http.StatusOK,
+			body:   `{"data": [{"id": "1"`,
+			error:  `Unable to Read n8n Workflows`,
+		},
+		"wrong types": {
+			status: http.StatusOK,
+			body:   `{"data": {"id": "1"}}`,
+			error:  `cannot unmarshal`,
+		},
+		"unauthorized": {
+			status: http.StatusUnauthorized,
+			body:   `{"message": "unauthorized"}`,
+			error:  `status: 401`,
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			server := newStandInServer(t, tc.status, tc.body)
+
+			resource.UnitTest(t, resource.TestCase{
+				ProtoV6ProviderFactories: testProtoV6ProviderFactoriesForURL(t, server.URL),
+				Steps: []resource.TestStep{
+					{
+						Config:      `data "n8n_workflows" "test" {}`,
+						ExpectError: regexp.MustCompile(tc.error),
+					},
+				},
+			})
+		})
+	}
+}
@@ -0,0 +1,250 @@
+// Copyright (c) Arthur Diniz <arthurbdiniz@gmail.com>
+// SPDX-License-Identifier: Apache-2.0
+
+// Package validation checks the structure of n8n workflows before they are
+// sent to n8n, so problems that n8n would only report when running or
+// activating a workflow are caught during plan.
+package validation
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/arthurbdiniz/terraform-provider-n8n/internal/pkg/n8n-client-go"
+)
+
+// Severity tells whether an issue prevents the workflow from working.
+type Severity int
+
+const (
+	// SeverityError marks a workflow n8n cannot run or activate as intended.
+	SeverityError Severity = iota
+
+	// SeverityWarning marks a workflow that works but is probably not what was meant.
+	SeverityWarning
+)
+
+// Issue is a problem found in a workflow.
+type Issue struct {
+	// Severity tells whether the issue is an error or a warning.
+	Severity Severity
+
+	// Path locates the problem in the workflow JSON document, for example
+	// nodes[2].name or connections["Webhook"].main[0][0].node.
+	Path string
+
+	// Message describes the problem.
+	Message string
+}
+
+// String returns the path and message of the issue.
+func (i Issue) String() string {
+	return i.Path + ": " + i.Message
+}
+
+// Options changes which checks ValidateWorkflow applies.
+type Options struct {
+	// RequireTrigger warns about workflows without an enabled trigger, poller
+	// or webhook node, which n8n refuses to activate.
+	RequireTrigger bool
+}
+
+// stickyNoteType is the type of the canvas annotations, which are never connected.
+const stickyNoteType = "n8n-nodes-base.stickyNote"
+
+// nonActivatingTriggers are trigger nodes that only start a workflow by hand,
+// as a sub-workflow or on the error of another workflow, and therefore do not
+// allow it to be activated.
+var nonActivatingTriggers = map[string]bool{
+	"n8n-nodes-base.manualTrigger":               true,
+	"n8n-nodes-base.start":                       true,
+	"n8n-nodes-base.executeWorkflowTrigger":      true,
+	"n8n-nodes-base.errorTrigger":                true,
+	"@n8n/n8n-nodes-langchain.manualChatTrigger": true,
+}
+
+// activatingNodes are the poller and webhook nodes allowing a workflow to be
+// activated whose type does not end with Trigger.
+var activatingNodes = map[string]bool{
+	"n8n-nodes-base.webhook":       true,
+	"n8n-nodes-base.cron":          true,
+	"n8n-nodes-base.interval":      true,
+	"n8n-nodes-base.emailReadImap": true,
+}
+
+// ValidateWorkflow checks the nodes and connections of a workflow and returns
+// the issues found, in document order. It reports:
+//   - nodes without a name, and node names or IDs used more than once;
+//   - connections from or to a node name that does not exist;
+//   - nodes without any connection, as warnings;
+//   - workflows without a trigger node, as warnings, when
+//     options.RequireTrigger is set. Trigger nodes are recognized by their
+//     type, so n8n has the final say when the workflow is activated.
+func ValidateWorkflow(workflow n8n.Workflow, options Options) []Issue {
+	var issues []Issue
+
+	names := make(map[string]int, len(workflow.Nodes))
+	ids := make(map[string]int, len(workflow.Nodes))
+	for i, node := range workflow.Nodes {
+		if node.Name == "" {
+			issues = append(issues, errorf(fmt.Sprintf("nodes[%d].name", i), "node name must not be empty"))
+		} else if first, ok := names[node.Name]; ok {
+			issues = append(issues, errorf(fmt.Sprintf("nodes[%d].name", i), "node name %q is already used by nodes[%d]", node.Name, first))
+		} else {
+			names[node.Name] = i
+		}
+
+		if node.ID == "" {
+			continue
+		}
+		if first, ok := ids[node.ID]; ok {
+			issues = append(issues, errorf(fmt.Sprintf("nodes[%d].id", i), "node ID %q is already used by nodes[%d]", node.ID, first))
+		} else {
+			ids[node.ID] = i
+		}
+	}
+
+	connected := make(map[string]bool)
+	sources := make([]string, 0, len(workflow.Connections))
+	for source := range workflow.Connections {
+		sources = append(sources, source)
+	}
+	sort.Strings(sources)
+
+	for _, source := range sources {
+		sourcePath := fmt.Sprintf("connections[%q]", source)
+		if _, ok := names[source]; !ok {
+			issues = append(issues, errorf(sourcePath, "connection source %q is not the name of a node", source))
+		}
+
+		outputs, err := connectionOutputs(workflow.Connections[source])
+		if err != nil {
+			issues = append(issues, errorf(sourcePath, "invalid connections: %s", err))
+			continue
+		}
+
+		for _, output := range outputs {
+			for i, targets := range output.targets {
+				for j, target := range targets {
+					if _, ok := names[target.Node]; !ok {
+						targetPath := fmt.Sprintf("%s.%s[%d][%d].node", sourcePath, output.name, i, j)
+						issues = append(issues, errorf(targetPath, "connection target %q is not the name of a node", target.Node))
+						continue
+					}
+					connected[source] = true
+					connected[target.Node] = true
+				}
+			}
+		}
+	}
+
+	if countNodes(workflow.Nodes) > 1 {
+		for i, node := range workflow.Nodes {
+			if node.Name == "" || node.Type == stickyNoteType || connected[node.Name] || names[node.Name] != i {
+				continue
+			}
+			issues = append(issues, Issue{
+				Severity: SeverityWarning,
+				Path:     fmt.Sprintf("nodes[%d]", i),
+				Message:  fmt.Sprintf("node %q is not connected to any other node and will never run", node.Name),
+			})
+		}
+	}
+
+	if options.RequireTrigger && !hasTrigger(workflow.Nodes) {
+		issues = append(issues, Issue{
+			Severity: SeverityWarning,
+			Path:     "nodes",
+			Message:  "an active workflow needs at least one enabled trigger, poller or webhook node; manual, Execute Workflow and Error triggers do not count",
+		})
+	}
+
+	return issues
+}
+
+// HasErrors reports whether any of the issues is an error.
+func HasErrors(issues []Issue) bool {
+	for _, issue := range issues {
+		if issue.Severity == SeverityError {
+			return true
+		}
+	}
+	return false
+}
+
+func errorf(path string, format string, args ...interface{}) Issue {
+	return Issue{Severity: SeverityError, Path: path, Message: fmt.Sprintf(format, args...)}
+}
+
+// output holds the targets of one output type of a node, such as main or
+// ai_tool, indexed by output and then by target.
+type output struct {
+	name    string
+	targets [][]n8n.ConnectionDetail
+}
+
+// connectionOutputs decodes the outputs of a node, main first and the other
+// output types sorted by name.
+func connectionOutputs(connection n8n.Connection) ([]output, error) {
+	var outputs []output
+
+	if len(connection.Main) > 0 {
+		targets, err := decodeTargets(connection.Main)
+		if err != nil {
+			return nil, fmt.Errorf("main: %w", err)
+		}
+		outputs = append(outputs, output{name: "main", targets: targets})
+	}
+
+	names := make([]string, 0, len(connection.Extra))
+	for name := range connection.Extra {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	for _, name := range names {
+		targets, err := decodeTargets(connection.Extra[name])
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", name, err)
+		}
+		outputs = append(outputs, output{name: name, targets: targets})
+	}
+
+	return outputs, nil
+}
+
+func decodeTargets(data json.RawMessage) ([][]n8n.ConnectionDetail, error) {
+	var targets [][]n8n.ConnectionDetail
+	if err := json.Unmarshal(data, &targets); err != nil {
+		return nil, err
+	}
+	return targets, nil
+}
+
+// countNodes returns the number of nodes, not counting sticky notes.
+func countNodes(nodes []n8n.Node) int {
+	count := 0
+	for _, node := range nodes {
+		if node.Type != stickyNoteType {
+			count++
+		}
+	}
+	return count
+}
+
+// hasTrigger reports whether a workflow has an enabled node able to start it
+// on its own: a trigger node other than nonActivatingTriggers, or one of the
+// activatingNodes.
+func hasTrigger(nodes []n8n.Node) bool {
+	for _, node := range nodes {
+		if node.Disabled || nonActivatingTriggers[node.Type] {
+			continue
+		}
+		if activatingNodes[node.Type] || strings.HasSuffix(node.Type, "Trigger") {
+			return true
+		}
+	}
+	return false
+}
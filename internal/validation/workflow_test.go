@@ -0,0 +1,114 @@
+// Copyright (c) Arthur Diniz <arthurbdiniz@gmail.com>
+// SPDX-License-Identifier: Apache-2.0
+
+package validation
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/arthurbdiniz/terraform-provider-n8n/internal/pkg/n8n-client-go"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func parseWorkflow(t *testing.T, document string) n8n.Workflow {
+	var workflow n8n.Workflow
+	require.NoError(t, json.Unmarshal([]byte(document), &workflow))
+	return workflow
+}
+
+func TestValidateWorkflow_Valid(t *testing.T) {
+	workflow := parseWorkflow(t, `{
+		"nodes": [
+			{"id": "1", "name": "Webhook", "type": "n8n-nodes-base.webhook"},
+			{"id": "2", "name": "Agent", "type": "@n8n/n8n-nodes-langchain.agent"},
+			{"id": "3", "name": "Model", "type": "@n8n/n8n-nodes-langchain.lmChatOpenAi"},
+			{"id": "4", "name": "Note", "type": "n8n-nodes-base.stickyNote"}
+		],
+		"connections": {
+			"Webhook": {"main": [[{"node": "Agent", "type": "main", "index": 0}]]},
+			"Model": {"ai_languageModel": [[{"node": "Agent", "type": "ai_languageModel", "index": 0}]]}
+		}
+	}`)
+
+	assert.Empty(t, ValidateWorkflow(workflow, Options{RequireTrigger: true}))
+}
+
+func TestValidateWorkflow_SingleNode(t *testing.T) {
+	workflow := parseWorkflow(t, `{"nodes": [{"name": "Every Hour", "type": "n8n-nodes-base.scheduleTrigger"}], "connections": {}}`)
+
+	assert.Empty(t, ValidateWorkflow(workflow, Options{RequireTrigger: true}))
+}
+
+func TestValidateWorkflow_Issues(t *testing.T) {
+	workflow := parseWorkflow(t, `{
+		"nodes": [
+			{"id": "1", "name": "Start", "type": "n8n-nodes-base.manualTrigger"},
+			{"id": "2", "name": "Set", "type": "n8n-nodes-base.set"},
+			{"id": "2", "name": "Set", "type": "n8n-nodes-base.set"},
+			{"id": "3", "name": "", "type": "n8n-nodes-base.noOp"},
+			{"id": "4", "name": "Unused", "type": "n8n-nodes-base.noOp"}
+		],
+		"connections": {
+			"Start": {"main": [[{"node": "Set", "type": "main", "index": 0}, {"node": "Sett", "type": "main", "index": 0}]]},
+			"Removed": {"main": [[{"node": "Set", "type": "main", "index": 0}]]}
+		}
+	}`)
+
+	issues := ValidateWorkflow(workflow, Options{RequireTrigger: true})
+
+	assert.True(t, HasErrors(issues))
+	assert.Equal(t, []Issue{
+		{Severity: SeverityError, Path: "nodes[2].name", Message: `node name "Set" is already used by nodes[1]`},
+		{Severity: SeverityError, Path: "nodes[2].id", Message: `node ID "2" is already used by nodes[1]`},
+		{Severity: SeverityError, Path: "nodes[3].name", Message: "node name must not be empty"},
+		{Severity: SeverityError, Path: `connections["Removed"]`, Message: `connection source "Removed" is not the name of a node`},
+		{Severity: SeverityError, Path: `connections["Start"].main[0][1].node`, Message: `connection target "Sett" is not the name of a node`},
+		{Severity: SeverityWarning, Path: "nodes[4]", Message: `node "Unused" is not connected to any other node and will never run`},
+		{Severity: SeverityWarning, Path: "nodes", Message: "an active workflow needs at least one enabled trigger, poller or webhook node; manual, Execute Workflow and Error triggers do not count"},
+	}, issues)
+}
+
+func TestValidateWorkflow_Trigger(t *testing.T) {
+	tests := map[string]struct {
+		node       string
+		hasTrigger bool
+	}{
+		"schedule":         {node: `{"name": "Trigger", "type": "n8n-nodes-base.scheduleTrigger"}`, hasTrigger: true},
+		"webhook":          {node: `{"name": "Trigger", "type": "n8n-nodes-base.webhook"}`, hasTrigger: true},
+		"disabled":         {node: `{"name": "Trigger", "type": "n8n-nodes-base.scheduleTrigger", "disabled": true}`, hasTrigger: false},
+		"manual":           {node: `{"name": "Trigger", "type": "n8n-nodes-base.manualTrigger"}`, hasTrigger: false},
+		"regular node":     {node: `{"name": "Trigger", "type": "n8n-nodes-base.set"}`, hasTrigger: false},
+		"langchain manual": {node: `{"name": "Trigger", "type": "@n8n/n8n-nodes-langchain.manualChatTrigger"}`, hasTrigger: false},
+		"imap poller":      {node: `{"name": "Trigger", "type": "n8n-nodes-base.emailReadImap"}`, hasTrigger: true},
+		"sub-workflow":     {node: `{"name": "Trigger", "type": "n8n-nodes-base.executeWorkflowTrigger"}`, hasTrigger: false},
+		"error workflow":   {node: `{"name": "Trigger", "type": "n8n-nodes-base.errorTrigger"}`, hasTrigger: false},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			workflow := parseWorkflow(t, `{"nodes": [`+tc.node+`], "connections": {}}`)
+
+			assert.Empty(t, ValidateWorkflow(workflow, Options{}), "triggers are only required on request")
+
+			// A missing trigger is a warning, as n8n decides on activation.
+			issues := ValidateWorkflow(workflow, Options{RequireTrigger: true})
+			assert.False(t, HasErrors(issues))
+			assert.Equal(t, !tc.hasTrigger, len(issues) == 1)
+		})
+	}
+}
+
+func TestValidateWorkflow_InvalidConnections(t *testing.T) {
+	workflow := parseWorkflow(t, `{
+		"nodes": [{"name": "Webhook", "type": "n8n-nodes-base.webhook"}],
+		"connections": {"Webhook": {"main": {"node": "Webhook"}}}
+	}`)
+
+	issues := ValidateWorkflow(workflow, Options{})
+
+	require.Len(t, issues, 1)
+	assert.Equal(t, `connections["Webhook"]`, issues[0].Path)
+	assert.Contains(t, issues[0].Message, "invalid connections: main:")
+}
@@ -25,11 +25,29 @@ func main() {
 	defer f.Close()
 
 	customContent := `
+### resources
+
+- [error_workflow_binding](./resources/error_workflow_binding.md)
+- [workflow](./resources/workflow.md)
+- [workflow_copy](./resources/workflow_copy.md)
+- [workflow_settings](./resources/workflow_settings.md)
+
 ### data-sources
 
 - [workflow](./data-sources/workflow.md)
 - [workflows](./data-sources/workflows.md)
 
+### ephemeral-resources
+
+- [api_token](./ephemeral-resources/api_token.md)
+
+### functions
+
+- [workflow_decode](./functions/workflow_decode.md)
+- [workflow_encode](./functions/workflow_encode.md)
+- [workflow_node](./functions/workflow_node.md)
+- [workflow_remap_sub_workflows](./functions/workflow_remap_sub_workflows.md)
+
 ---
 
 ## n8n-client-go